@@ -0,0 +1,65 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import (
+	"net/http"
+
+	"golang.org/x/oauth2"
+)
+
+// SetAccessKey makes the SDK authenticate its API requests with the access key and secret of a Casdoor user,
+// generated in the account page of the user, instead of the client id and secret of the application,
+// which then don't have to be shared with every service. The requests are allowed what the user is allowed.
+// Empty keys restore the authentication with the client id and secret.
+func (c *Client) SetAccessKey(accessKey string, accessSecret string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.accessKey = accessKey
+	c.accessSecret = accessSecret
+}
+
+// SetTokenSource makes the SDK authenticate its API requests with the Bearer tokens of tokenSource,
+// e.g. NewTokenSource of the token of a user, instead of the client id and secret of the application.
+// It takes precedence over SetAccessKey and EnableServiceTokenAuth. A nil tokenSource restores them.
+func (c *Client) SetTokenSource(tokenSource oauth2.TokenSource) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.tokenSource = tokenSource
+}
+
+func (c *Client) getAccessKey() (string, string) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.accessKey, c.accessSecret
+}
+
+func (c *Client) getTokenSource() oauth2.TokenSource {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.tokenSource
+}
+
+// setAccessKey adds the access key and secret to the query of req, where the server reads them from.
+func setAccessKey(req *http.Request, accessKey string, accessSecret string) {
+	query := req.URL.Query()
+	query.Set("accessKey", accessKey)
+	query.Set("accessSecret", accessSecret)
+	req.URL.RawQuery = query.Encode()
+}
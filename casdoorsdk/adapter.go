@@ -0,0 +1,152 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// Adapter has the same definition as https://github.com/casdoor/casdoor/blob/master/object/adapter.go#L31
+type Adapter struct {
+	Owner       string `xorm:"varchar(100) notnull pk" json:"owner"`
+	Name        string `xorm:"varchar(100) notnull pk" json:"name"`
+	CreatedTime string `xorm:"varchar(100)" json:"createdTime"`
+
+	Table     string `xorm:"varchar(100)" json:"table"`
+	UseSameDb bool   `json:"useSameDb"`
+
+	Type         string `xorm:"varchar(100)" json:"type"`
+	DatabaseType string `xorm:"varchar(100)" json:"databaseType"`
+	Host         string `xorm:"varchar(100)" json:"host"`
+	Port         int    `json:"port"`
+	User         string `xorm:"varchar(100)" json:"user"`
+	Password     string `xorm:"varchar(100)" json:"password"`
+	Database     string `xorm:"varchar(100)" json:"database"`
+}
+
+func (c *Client) GetAdapters() ([]*Adapter, error) {
+	return c.GetAdaptersWithContext(context.Background())
+}
+
+func (c *Client) GetAdaptersWithContext(ctx context.Context) ([]*Adapter, error) {
+	authConfig := c.getAuthConfig()
+
+	queryMap := map[string]string{
+		"owner": authConfig.OrganizationName,
+	}
+
+	url := c.GetUrl("get-adapters", queryMap)
+
+	bytes, err := c.DoGetBytesWithContext(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	var adapters []*Adapter
+	err = c.unmarshal(bytes, &adapters)
+	if err != nil {
+		return nil, err
+	}
+	return adapters, nil
+}
+
+func (c *Client) GetPaginationAdapters(p int, pageSize int, queryMap map[string]string) ([]*Adapter, int, error) {
+	return c.GetPaginationAdaptersWithContext(context.Background(), p, pageSize, queryMap)
+}
+
+func (c *Client) GetPaginationAdaptersWithContext(ctx context.Context, p int, pageSize int, queryMap map[string]string) ([]*Adapter, int, error) {
+	authConfig := c.getAuthConfig()
+
+	queryMap = getPaginationQueryMap(authConfig.OrganizationName, p, pageSize, queryMap)
+
+	url := c.GetUrl("get-adapters", queryMap)
+
+	response, err := c.DoGetResponseWithContext(ctx, url)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	bytes, err := json.Marshal(response.Data)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var adapters []*Adapter
+	err = c.unmarshal(bytes, &adapters)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	count, err := getCount(response)
+	if err != nil {
+		return nil, 0, err
+	}
+	return adapters, count, nil
+}
+
+func (c *Client) GetAdapter(name string) (*Adapter, error) {
+	return c.GetAdapterWithContext(context.Background(), name)
+}
+
+func (c *Client) GetAdapterWithContext(ctx context.Context, name string) (*Adapter, error) {
+	authConfig := c.getAuthConfig()
+
+	queryMap := map[string]string{
+		"id": fmt.Sprintf("%s/%s", authConfig.OrganizationName, name),
+	}
+
+	url := c.GetUrl("get-adapter", queryMap)
+
+	bytes, err := c.DoGetBytesWithContext(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	var adapter *Adapter
+	err = c.unmarshal(bytes, &adapter)
+	if err != nil {
+		return nil, err
+	}
+	return adapter, nil
+}
+
+func (c *Client) UpdateAdapter(adapter *Adapter) (bool, error) {
+	return c.UpdateAdapterWithContext(context.Background(), adapter)
+}
+
+func (c *Client) UpdateAdapterWithContext(ctx context.Context, adapter *Adapter) (bool, error) {
+	_, affected, err := c.modifyAdapter(ctx, "update-adapter", adapter, nil)
+	return affected, err
+}
+
+func (c *Client) AddAdapter(adapter *Adapter) (bool, error) {
+	return c.AddAdapterWithContext(context.Background(), adapter)
+}
+
+func (c *Client) AddAdapterWithContext(ctx context.Context, adapter *Adapter) (bool, error) {
+	_, affected, err := c.modifyAdapter(ctx, "add-adapter", adapter, nil)
+	return affected, err
+}
+
+func (c *Client) DeleteAdapter(adapter *Adapter) (bool, error) {
+	return c.DeleteAdapterWithContext(context.Background(), adapter)
+}
+
+func (c *Client) DeleteAdapterWithContext(ctx context.Context, adapter *Adapter) (bool, error) {
+	_, affected, err := c.modifyAdapter(ctx, "delete-adapter", adapter, nil)
+	return affected, err
+}
@@ -14,7 +14,14 @@
 
 package casdoorsdk
 
-import "encoding/json"
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
 
 type ProviderItem struct {
 	Name      string    `json:"name"`
@@ -58,25 +65,174 @@ type Application struct {
 	GrantTypes          []string        `xorm:"varchar(1000)" json:"grantTypes"`
 	OrganizationObj     *Organization   `xorm:"-" json:"organizationObj"`
 
-	ClientId             string   `xorm:"varchar(100)" json:"clientId"`
-	ClientSecret         string   `xorm:"varchar(100)" json:"clientSecret"`
-	RedirectUris         []string `xorm:"varchar(1000)" json:"redirectUris"`
-	TokenFormat          string   `xorm:"varchar(100)" json:"tokenFormat"`
-	ExpireInHours        int      `json:"expireInHours"`
-	RefreshExpireInHours int      `json:"refreshExpireInHours"`
-	SignupUrl            string   `xorm:"varchar(200)" json:"signupUrl"`
-	SigninUrl            string   `xorm:"varchar(200)" json:"signinUrl"`
-	ForgetUrl            string   `xorm:"varchar(200)" json:"forgetUrl"`
-	AffiliationUrl       string   `xorm:"varchar(100)" json:"affiliationUrl"`
-	TermsOfUse           string   `xorm:"varchar(100)" json:"termsOfUse"`
-	SignupHtml           string   `xorm:"mediumtext" json:"signupHtml"`
-	SigninHtml           string   `xorm:"mediumtext" json:"signinHtml"`
-	FormCss              string   `xorm:"text" json:"formCss"`
-	FormOffset           int      `json:"formOffset"`
-	FormBackgroundUrl    string   `xorm:"varchar(200)" json:"formBackgroundUrl"`
-}
-
-func AddApplication(application *Application) (bool, error) {
+	ClientId             string     `xorm:"varchar(100)" json:"clientId"`
+	ClientSecret         string     `xorm:"varchar(100)" json:"clientSecret"`
+	RedirectUris         []string   `xorm:"varchar(1000)" json:"redirectUris"`
+	TokenFormat          string     `xorm:"varchar(100)" json:"tokenFormat"`
+	ExpireInHours        int        `json:"expireInHours"`
+	RefreshExpireInHours int        `json:"refreshExpireInHours"`
+	SignupUrl            string     `xorm:"varchar(200)" json:"signupUrl"`
+	SigninUrl            string     `xorm:"varchar(200)" json:"signinUrl"`
+	ForgetUrl            string     `xorm:"varchar(200)" json:"forgetUrl"`
+	AffiliationUrl       string     `xorm:"varchar(100)" json:"affiliationUrl"`
+	TermsOfUse           string     `xorm:"varchar(100)" json:"termsOfUse"`
+	SignupHtml           string     `xorm:"mediumtext" json:"signupHtml"`
+	SigninHtml           string     `xorm:"mediumtext" json:"signinHtml"`
+	FormCss              string     `xorm:"text" json:"formCss"`
+	FormOffset           int        `json:"formOffset"`
+	FormBackgroundUrl    string     `xorm:"varchar(200)" json:"formBackgroundUrl"`
+	ThemeData            *ThemeData `xorm:"json" json:"themeData"`
+}
+
+func (c *Client) GetApplications() ([]*Application, error) {
+	return c.GetApplicationsWithContext(context.Background())
+}
+
+func (c *Client) GetApplicationsWithContext(ctx context.Context) ([]*Application, error) {
+	queryMap := map[string]string{
+		"owner": "admin",
+	}
+
+	url := c.GetUrl("get-applications", queryMap)
+
+	bytes, err := c.DoGetBytesWithContext(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	var applications []*Application
+	err = c.unmarshal(bytes, &applications)
+	if err != nil {
+		return nil, err
+	}
+	return applications, nil
+}
+
+func (c *Client) GetPaginationApplications(p int, pageSize int, queryMap map[string]string) ([]*Application, int, error) {
+	return c.GetPaginationApplicationsWithContext(context.Background(), p, pageSize, queryMap)
+}
+
+func (c *Client) GetPaginationApplicationsWithContext(ctx context.Context, p int, pageSize int, queryMap map[string]string) ([]*Application, int, error) {
+	queryMap = getPaginationQueryMap("admin", p, pageSize, queryMap)
+
+	url := c.GetUrl("get-applications", queryMap)
+
+	response, err := c.DoGetResponseWithContext(ctx, url)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	bytes, err := json.Marshal(response.Data)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var applications []*Application
+	err = c.unmarshal(bytes, &applications)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	count, err := getCount(response)
+	if err != nil {
+		return nil, 0, err
+	}
+	return applications, count, nil
+}
+
+// GetOrganizationApplications returns the applications of the configured organization.
+func (c *Client) GetOrganizationApplications() ([]*Application, error) {
+	return c.GetOrganizationApplicationsWithContext(context.Background())
+}
+
+func (c *Client) GetOrganizationApplicationsWithContext(ctx context.Context) ([]*Application, error) {
+	authConfig := c.getAuthConfig()
+
+	queryMap := map[string]string{
+		"owner":        "admin",
+		"organization": authConfig.OrganizationName,
+	}
+
+	url := c.GetUrl("get-organization-applications", queryMap)
+
+	bytes, err := c.DoGetBytesWithContext(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	var applications []*Application
+	err = c.unmarshal(bytes, &applications)
+	if err != nil {
+		return nil, err
+	}
+	return applications, nil
+}
+
+func (c *Client) GetApplication(name string) (*Application, error) {
+	return c.GetApplicationWithContext(context.Background(), name)
+}
+
+func (c *Client) GetApplicationWithContext(ctx context.Context, name string) (*Application, error) {
+	queryMap := map[string]string{
+		"id": fmt.Sprintf("admin/%s", name),
+	}
+
+	url := c.GetUrl("get-application", queryMap)
+
+	bytes, err := c.DoGetBytesRawWithContext(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	var application *Application
+	err = c.unmarshal(bytes, &application)
+	if err != nil {
+		return nil, err
+	}
+	if application == nil {
+		return nil, c.newAPIError(fmt.Sprintf("the application: %s doesn't exist", name))
+	}
+	return application, nil
+}
+
+// applicationTtl is how long the configured application is cached by getCachedApplication.
+const applicationTtl = 5 * time.Minute
+
+// getCachedApplication returns the configured application, which is fetched by the first call, and then refreshed
+// in the background every applicationTtl.
+func (c *Client) getCachedApplication(ctx context.Context) (*Application, error) {
+	authConfig := c.getAuthConfig()
+	key := authConfig.Endpoint + "/" + authConfig.ApplicationName
+	return c.application.get(ctx, key, applicationTtl, func(ctx context.Context) (*Application, error) {
+		return c.GetApplicationWithContext(ctx, authConfig.ApplicationName)
+	})
+}
+
+// IsRedirectUriValid reports whether redirectUri is allowed by the redirect URIs registered in the application.
+// It matches them the way the server does: a registered URI is a regular expression, or a substring of redirectUri.
+func (application *Application) IsRedirectUriValid(redirectUri string) bool {
+	for _, targetUri := range application.RedirectUris {
+		if targetUri == "" {
+			continue
+		}
+
+		if strings.Contains(redirectUri, targetUri) {
+			return true
+		}
+
+		targetUriRegex, err := regexp.Compile(targetUri)
+		if err == nil && targetUriRegex.MatchString(redirectUri) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *Client) AddApplication(application *Application) (bool, error) {
+	return c.AddApplicationWithContext(context.Background(), application)
+}
+
+func (c *Client) AddApplicationWithContext(ctx context.Context, application *Application) (bool, error) {
 	if application.Owner == "" {
 		application.Owner = "admin"
 	}
@@ -85,15 +241,44 @@ func AddApplication(application *Application) (bool, error) {
 		return false, err
 	}
 
-	resp, err := DoPost("add-application", nil, postBytes, false, false)
+	resp, err := c.DoPostWithContext(ctx, "add-application", nil, postBytes, false, false)
 	if err != nil {
 		return false, err
 	}
 
-	return resp.Data == "Affected", nil
+	return isAffected(resp), nil
+}
+
+func (c *Client) UpdateApplication(application *Application) (bool, error) {
+	return c.UpdateApplicationWithContext(context.Background(), application)
+}
+
+func (c *Client) UpdateApplicationWithContext(ctx context.Context, application *Application) (bool, error) {
+	if application.Owner == "" {
+		application.Owner = "admin"
+	}
+	queryMap := map[string]string{
+		"id": fmt.Sprintf("%s/%s", application.Owner, application.Name),
+	}
+
+	postBytes, err := json.Marshal(application)
+	if err != nil {
+		return false, err
+	}
+
+	resp, err := c.DoPostWithContext(ctx, "update-application", queryMap, postBytes, false, false)
+	if err != nil {
+		return false, err
+	}
+
+	return isAffected(resp), nil
+}
+
+func (c *Client) DeleteApplication(name string) (bool, error) {
+	return c.DeleteApplicationWithContext(context.Background(), name)
 }
 
-func DeleteApplication(name string) (bool, error) {
+func (c *Client) DeleteApplicationWithContext(ctx context.Context, name string) (bool, error) {
 	application := Application{
 		Owner: "admin",
 		Name:  name,
@@ -103,10 +288,10 @@ func DeleteApplication(name string) (bool, error) {
 		return false, err
 	}
 
-	resp, err := DoPost("delete-application", nil, postBytes, false, false)
+	resp, err := c.DoPostWithContext(ctx, "delete-application", nil, postBytes, false, false)
 	if err != nil {
 		return false, err
 	}
 
-	return resp.Data == "Affected", nil
+	return isAffected(resp), nil
 }
@@ -0,0 +1,73 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import "context"
+
+func GetApplications() ([]*Application, error) {
+	return globalClient.GetApplications()
+}
+
+func GetApplicationsWithContext(ctx context.Context) ([]*Application, error) {
+	return globalClient.GetApplicationsWithContext(ctx)
+}
+
+func GetPaginationApplications(p int, pageSize int, queryMap map[string]string) ([]*Application, int, error) {
+	return globalClient.GetPaginationApplications(p, pageSize, queryMap)
+}
+
+func GetPaginationApplicationsWithContext(ctx context.Context, p int, pageSize int, queryMap map[string]string) ([]*Application, int, error) {
+	return globalClient.GetPaginationApplicationsWithContext(ctx, p, pageSize, queryMap)
+}
+
+func GetOrganizationApplications() ([]*Application, error) {
+	return globalClient.GetOrganizationApplications()
+}
+
+func GetOrganizationApplicationsWithContext(ctx context.Context) ([]*Application, error) {
+	return globalClient.GetOrganizationApplicationsWithContext(ctx)
+}
+
+func GetApplication(name string) (*Application, error) {
+	return globalClient.GetApplication(name)
+}
+
+func GetApplicationWithContext(ctx context.Context, name string) (*Application, error) {
+	return globalClient.GetApplicationWithContext(ctx, name)
+}
+
+func AddApplication(application *Application) (bool, error) {
+	return globalClient.AddApplication(application)
+}
+
+func AddApplicationWithContext(ctx context.Context, application *Application) (bool, error) {
+	return globalClient.AddApplicationWithContext(ctx, application)
+}
+
+func UpdateApplication(application *Application) (bool, error) {
+	return globalClient.UpdateApplication(application)
+}
+
+func UpdateApplicationWithContext(ctx context.Context, application *Application) (bool, error) {
+	return globalClient.UpdateApplicationWithContext(ctx, application)
+}
+
+func DeleteApplication(name string) (bool, error) {
+	return globalClient.DeleteApplication(name)
+}
+
+func DeleteApplicationWithContext(ctx context.Context, name string) (bool, error) {
+	return globalClient.DeleteApplicationWithContext(ctx, name)
+}
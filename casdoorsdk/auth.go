@@ -14,8 +14,18 @@
 
 package casdoorsdk
 
+import (
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
 // AuthConfig is the core configuration.
-// The first step to use this SDK is to use the InitConfig function to initialize the global authConfig.
+// The first step to use this SDK is to use the InitConfig function to initialize the configuration
+// of the package-level functions, or NewClient to create a client with its own configuration.
 type AuthConfig struct {
 	Endpoint         string
 	ClientId         string
@@ -25,15 +35,88 @@ type AuthConfig struct {
 	ApplicationName  string
 }
 
-var authConfig AuthConfig
+// Client sends requests to a Casdoor server with its own configuration, so that a process can talk to
+// several servers or organizations at the same time. The package-level functions use a default client,
+// configured by InitConfig and the package-level Set* functions.
+// A Client is safe for concurrent use, and its configuration may be changed at any time, concurrently
+// with requests: every access goes through mu, and each call works on a snapshot of the configuration
+// taken when it starts.
+type Client struct {
+	mu             sync.RWMutex
+	config         AuthConfig
+	httpClient     HttpClient
+	language       string
+	strictDecoding bool
+	debugWriter    io.Writer
+	rateLimitHook  RateLimitHook
+	retryOptions   RetryOptions
+	secretProvider SecretProvider
+	serviceTokens  *serviceTokenCache
+	accessKey      string
+	accessSecret   string
+	tokenSource    oauth2.TokenSource
+	requestHooks   []RequestHook
+	responseHooks  []ResponseHook
+	logger         Logger
+	limiter        Limiter
+	circuitBreaker *circuitBreaker
+
+	verificationKeysMutex sync.RWMutex
+	// verificationKeysEndpoint is the endpoint of the server verificationKeys were fetched from.
+	verificationKeysEndpoint  string
+	verificationKeys          map[string]*verificationKey
+	verificationKeysFetchedAt time.Time
+	// verificationKeysFetchMutex serializes the fetches of the keys by ParseJwtToken.
+	verificationKeysFetchMutex      sync.Mutex
+	verificationKeysAttemptEndpoint string
+	verificationKeysAttemptAt       time.Time
+
+	capabilitiesMutex    sync.Mutex
+	capabilities         *Capabilities
+	capabilitiesEndpoint string
+	capabilitiesFetches  singleFlight[*Capabilities]
+
+	openIDConfiguration refreshingCache[*OpenIDConfiguration]
+	application         refreshingCache[*Application]
+	applicationCert     refreshingCache[*Cert]
+}
+
+// NewClient returns a client configured with config.
+func NewClient(config *AuthConfig) *Client {
+	return &Client{
+		config:     *config,
+		httpClient: &http.Client{},
+	}
+}
+
+// globalClient is the client of the package-level functions.
+var globalClient = NewClient(&AuthConfig{})
 
 func InitConfig(endpoint string, clientId string, clientSecret string, certificate string, organizationName string, applicationName string) {
-	authConfig = AuthConfig{
+	globalClient.setConfig(&AuthConfig{
 		Endpoint:         endpoint,
 		ClientId:         clientId,
 		ClientSecret:     clientSecret,
 		Certificate:      certificate,
 		OrganizationName: organizationName,
 		ApplicationName:  applicationName,
-	}
+	})
+}
+
+// setConfig replaces the configuration of c.
+func (c *Client) setConfig(config *AuthConfig) {
+	c.mu.Lock()
+	c.config = *config
+	c.mu.Unlock()
+
+	c.resetCapabilities()
+	c.resetVerificationKeys()
+}
+
+// getAuthConfig returns a snapshot of the configuration.
+func (c *Client) getAuthConfig() AuthConfig {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.config
 }
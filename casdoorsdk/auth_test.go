@@ -0,0 +1,120 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+// newTestServer returns a server answering every request with the user named "alice".
+func newTestServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"owner":"built-in","name":"alice"}`))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+// runConcurrently runs the functions of fns concurrently, each n times, and waits for them.
+func runConcurrently(n int, fns ...func(i int)) {
+	var wg sync.WaitGroup
+	for _, fn := range fns {
+		wg.Add(1)
+		go func(fn func(i int)) {
+			defer wg.Done()
+			for i := 0; i < n; i++ {
+				fn(i)
+			}
+		}(fn)
+	}
+	wg.Wait()
+}
+
+// TestInitConfigConcurrently reconfigures the global client while requests are sent with it,
+// and is meant to be run with -race.
+func TestInitConfigConcurrently(t *testing.T) {
+	server := newTestServer(t)
+	InitConfig(server.URL, "client-id", "client-secret", "", "built-in", "app-built-in")
+
+	runConcurrently(50,
+		func(i int) {
+			InitConfig(server.URL, "client-id", "client-secret", "", "built-in", "app-built-in")
+		},
+		func(i int) {
+			SetHttpClient(&http.Client{Timeout: time.Minute})
+		},
+		func(i int) {
+			user, err := GetUser("alice")
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			if user.Name != "alice" {
+				t.Errorf("got user %q, want alice", user.Name)
+			}
+		},
+		func(i int) {
+			_ = GetSignupUrl(true, "https://example.com/callback")
+		},
+	)
+}
+
+// TestClientSettersConcurrently changes the settings of a client while requests are sent with it,
+// and is meant to be run with -race.
+func TestClientSettersConcurrently(t *testing.T) {
+	server := newTestServer(t)
+	client := NewClient(&AuthConfig{
+		Endpoint:         server.URL,
+		ClientId:         "client-id",
+		ClientSecret:     "client-secret",
+		OrganizationName: "built-in",
+		ApplicationName:  "app-built-in",
+	})
+
+	runConcurrently(50,
+		func(i int) {
+			client.SetLanguage([]string{"en", "zh"}[i%2])
+			client.SetRetryOptions(RetryOptions{MaxAttempts: i % 3})
+			client.SetCircuitBreakerOptions(CircuitBreakerOptions{FailureThreshold: i % 2 * 5})
+		},
+		func(i int) {
+			client.SetHttpClient(&http.Client{})
+			client.SetLimiter(NewTokenBucketLimiter(0, 1))
+			client.SetRateLimitHook(func(rateLimit RateLimit) {})
+		},
+		func(i int) {
+			client.OnResponse(func(req *http.Request, resp *http.Response, err error, duration time.Duration) {})
+		},
+		func(i int) {
+			_, err := client.GetUser("alice")
+			if err != nil {
+				t.Error(err)
+			}
+		},
+		func(i int) {
+			_, err := client.GetUser("alice")
+			if err != nil {
+				t.Error(err)
+			}
+		},
+	)
+}
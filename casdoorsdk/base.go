@@ -16,22 +16,63 @@ package casdoorsdk
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"net/http"
 	"strings"
-)
-
-var (
-	// client is a shared http Client.
-	client HttpClient = &http.Client{}
+	"time"
 )
 
 // SetHttpClient sets custom http Client.
-func SetHttpClient(httpClient HttpClient) {
-	client = httpClient
+func (c *Client) SetHttpClient(httpClient HttpClient) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.httpClient = httpClient
+}
+
+func (c *Client) getHttpClient() HttpClient {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.httpClient
+}
+
+// SetStrictDecoding enables or disables strict decoding of the objects returned by the server.
+// When enabled, decoding fails on any field the SDK structs do not model, which helps to detect
+// server fields that would otherwise be silently dropped (and wiped by a later update call).
+func (c *Client) SetStrictDecoding(strict bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.strictDecoding = strict
+}
+
+func (c *Client) isStrictDecoding() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.strictDecoding
+}
+
+// SetLanguage sets the language the server answers in, like "en", "zh" or "fr", so that the messages
+// of the returned *APIError can be shown to the end user. The Code of the errors doesn't depend on it.
+// It can be overridden for a request by its own Accept-Language header.
+func (c *Client) SetLanguage(lang string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.language = lang
+}
+
+func (c *Client) getLanguage() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.language
 }
 
 // HttpClient interface has the method required to use a type as custom http client.
@@ -48,8 +89,12 @@ type Response struct {
 }
 
 // DoGetResponse is a general function to get response from param url through HTTP Get method.
-func DoGetResponse(url string) (*Response, error) {
-	respBytes, err := DoGetBytesRaw(url)
+func (c *Client) DoGetResponse(url string) (*Response, error) {
+	return c.DoGetResponseWithContext(context.Background(), url)
+}
+
+func (c *Client) DoGetResponseWithContext(ctx context.Context, url string) (*Response, error) {
+	respBytes, statusCode, err := c.doGet(ctx, url)
 	if err != nil {
 		return nil, err
 	}
@@ -61,15 +106,19 @@ func DoGetResponse(url string) (*Response, error) {
 	}
 
 	if response.Status != "ok" {
-		return nil, fmt.Errorf(response.Msg)
+		return nil, c.newResponseError("GET", url, statusCode, respBytes, response.Msg)
 	}
 
 	return &response, nil
 }
 
 // DoGetBytes is a general function to get response data in bytes from param url through HTTP Get method.
-func DoGetBytes(url string) ([]byte, error) {
-	response, err := DoGetResponse(url)
+func (c *Client) DoGetBytes(url string) ([]byte, error) {
+	return c.DoGetBytesWithContext(context.Background(), url)
+}
+
+func (c *Client) DoGetBytesWithContext(ctx context.Context, url string) ([]byte, error) {
+	response, err := c.DoGetResponseWithContext(ctx, url)
 	if err != nil {
 		return nil, err
 	}
@@ -83,35 +132,52 @@ func DoGetBytes(url string) ([]byte, error) {
 }
 
 // DoGetBytesRaw is a general function to get response from param url through HTTP Get method.
-func DoGetBytesRaw(url string) ([]byte, error) {
-	req, err := http.NewRequest("GET", url, nil)
+func (c *Client) DoGetBytesRaw(url string) ([]byte, error) {
+	return c.DoGetBytesRawWithContext(context.Background(), url)
+}
+
+func (c *Client) DoGetBytesRawWithContext(ctx context.Context, url string) ([]byte, error) {
+	respBytes, statusCode, err := c.doGet(ctx, url)
 	if err != nil {
 		return nil, err
 	}
 
-	req.SetBasicAuth(authConfig.ClientId, authConfig.ClientSecret)
-
-	resp, err := client.Do(req)
+	err = c.checkErrorResponse("GET", url, statusCode, respBytes)
 	if err != nil {
 		return nil, err
 	}
-	defer func(Body io.ReadCloser) {
-		err := Body.Close()
-		if err != nil {
-			return
-		}
-	}(resp.Body)
+	return respBytes, nil
+}
 
-	respBytes, err := ioutil.ReadAll(resp.Body)
+// checkErrorResponse returns an APIError if respBytes is an error Response, which the endpoints returning
+// a bare object or list send when they fail, instead of letting it be decoded as the expected object.
+func (c *Client) checkErrorResponse(method string, url string, statusCode int, respBytes []byte) error {
+	var response struct {
+		Status string  `json:"status"`
+		Msg    *string `json:"msg"`
+	}
+	if json.Unmarshal(respBytes, &response) != nil || response.Status != "error" || response.Msg == nil {
+		return nil
+	}
+	return c.newResponseError(method, url, statusCode, respBytes, *response.Msg)
+}
+
+// doGet sends an authenticated GET request to url and returns the body and the HTTP status of the response.
+func (c *Client) doGet(ctx context.Context, url string) ([]byte, int, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
-		return nil, err
+		return nil, 0, newRequestError("GET", url, err)
 	}
 
-	return respBytes, nil
+	return c.doRequestWithRetries(req)
+}
+
+func (c *Client) DoPost(action string, queryMap map[string]string, postBytes []byte, isForm, isFile bool) (*Response, error) {
+	return c.DoPostWithContext(context.Background(), action, queryMap, postBytes, isForm, isFile)
 }
 
-func DoPost(action string, queryMap map[string]string, postBytes []byte, isForm, isFile bool) (*Response, error) {
-	url := GetUrl(action, queryMap)
+func (c *Client) DoPostWithContext(ctx context.Context, action string, queryMap map[string]string, postBytes []byte, isForm, isFile bool) (*Response, error) {
+	url := c.GetUrl(action, queryMap)
 
 	var err error
 	var contentType string
@@ -139,7 +205,7 @@ func DoPost(action string, queryMap map[string]string, postBytes []byte, isForm,
 		body = bytes.NewReader(postBytes)
 	}
 
-	respBytes, err := DoPostBytesRaw(url, contentType, body)
+	respBytes, statusCode, err := c.doPost(ctx, url, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -151,31 +217,118 @@ func DoPost(action string, queryMap map[string]string, postBytes []byte, isForm,
 	}
 
 	if response.Status != "ok" {
-		return nil, fmt.Errorf(response.Msg)
+		return nil, c.newResponseError("POST", url, statusCode, respBytes, response.Msg)
 	}
 
 	return &response, nil
 }
 
 // DoPostBytesRaw is a general function to post a request from url, body through HTTP Post method.
-func DoPostBytesRaw(url string, contentType string, body io.Reader) ([]byte, error) {
+func (c *Client) DoPostBytesRaw(url string, contentType string, body io.Reader) ([]byte, error) {
+	return c.DoPostBytesRawWithContext(context.Background(), url, contentType, body)
+}
+
+func (c *Client) DoPostBytesRawWithContext(ctx context.Context, url string, contentType string, body io.Reader) ([]byte, error) {
+	respBytes, _, err := c.doPost(ctx, url, contentType, body)
+	return respBytes, err
+}
+
+// doPost sends an authenticated POST request to url and returns the body and the HTTP status of the response.
+func (c *Client) doPost(ctx context.Context, url string, contentType string, body io.Reader) ([]byte, int, error) {
+	return c.doPostWithLength(ctx, url, contentType, body, -1)
+}
+
+// doPostWithLength is doPost for a body of contentLength bytes, which is needed for the bodies that are streamed.
+// A negative contentLength leaves it to be detected from body, or else sent chunked.
+func (c *Client) doPostWithLength(ctx context.Context, url string, contentType string, body io.Reader, contentLength int64) ([]byte, int, error) {
 	if contentType == "" {
 		contentType = "text/plain;charset=UTF-8"
 	}
 
-	var resp *http.Response
-
-	req, err := http.NewRequest("POST", url, body)
+	req, err := http.NewRequestWithContext(ctx, "POST", url, body)
 	if err != nil {
-		return nil, err
+		return nil, 0, newRequestError("POST", url, err)
+	}
+	if contentLength >= 0 {
+		req.ContentLength = contentLength
 	}
 
-	req.SetBasicAuth(authConfig.ClientId, authConfig.ClientSecret)
 	req.Header.Set("Content-Type", contentType)
 
-	resp, err = client.Do(req)
+	return c.doRequestWithRetries(req)
+}
+
+// setAuthorization authenticates req with the token source or the access key if they are set,
+// or else with the service token if it is enabled, or else with the client id and secret.
+func (c *Client) setAuthorization(req *http.Request) error {
+	authConfig := c.getAuthConfig()
+
+	if tokenSource := c.getTokenSource(); tokenSource != nil {
+		token, err := tokenSource.Token()
+		if err != nil {
+			return err
+		}
+
+		token.SetAuthHeader(req)
+		return nil
+	}
+
+	if accessKey, accessSecret := c.getAccessKey(); accessKey != "" {
+		setAccessKey(req, accessKey, accessSecret)
+		return nil
+	}
+
+	if serviceTokens := c.getServiceTokens(); serviceTokens != nil {
+		token, err := serviceTokens.get(req.Context())
+		if err != nil {
+			return err
+		}
+
+		token.SetAuthHeader(req)
+		return nil
+	}
+
+	clientSecret, err := c.getClientSecret()
 	if err != nil {
-		return nil, err
+		return err
+	}
+
+	req.SetBasicAuth(authConfig.ClientId, clientSecret)
+	return nil
+}
+
+// doRequest sends req and returns the JSON body and the HTTP status of the response.
+// If w isn't nil, the body of a successful response is copied to w instead, whatever its type, and no body
+// is returned. Every error is wrapped into a RequestError, so that it tells which call failed.
+func (c *Client) doRequest(req *http.Request, w io.Writer) ([]byte, int, error) {
+	lang := c.getLanguage()
+	if lang != "" && req.Header.Get("Accept-Language") == "" {
+		req.Header.Set("Accept-Language", lang)
+	}
+
+	requestHooks, responseHooks := c.getHooks()
+	err := callRequestHooks(requestHooks, req)
+	if err != nil {
+		return nil, 0, newRequestError(req.Method, req.URL.String(), err)
+	}
+
+	debugWriter := c.getDebugWriter()
+	if debugWriter != nil {
+		dumpRequest(debugWriter, req)
+	}
+
+	logger := c.getLogger()
+	start := time.Now()
+	observe := func(resp *http.Response, body []byte, err error) {
+		duration := time.Since(start)
+		callResponseHooks(responseHooks, req, resp, body, err, duration)
+		logRequest(logger, req, resp, err, duration)
+	}
+
+	resp, err := c.getHttpClient().Do(req)
+	if err != nil {
+		observe(nil, nil, err)
+		return nil, 0, newRequestError(req.Method, req.URL.String(), classifyTransportError(err))
 	}
 	defer func(Body io.ReadCloser) {
 		err := Body.Close()
@@ -184,21 +337,72 @@ func DoPostBytesRaw(url string, contentType string, body io.Reader) ([]byte, err
 		}
 	}(resp.Body)
 
-	respByte, err := ioutil.ReadAll(resp.Body)
+	if w != nil && resp.StatusCode >= http.StatusOK && resp.StatusCode < http.StatusMultipleChoices {
+		// part of the body may have been written to w, so a failure to copy it isn't retryable
+		_, err = io.Copy(w, resp.Body)
+		observe(resp, nil, err)
+		if err != nil {
+			return nil, 0, newRequestError(req.Method, req.URL.String(), err)
+		}
+		return nil, resp.StatusCode, nil
+	}
+
+	var respBytes []byte
+	if w != nil {
+		// the body of a failed download only matters for the error
+		respBytes, err = ioutil.ReadAll(io.LimitReader(resp.Body, maxBodySnippetLength))
+	} else {
+		respBytes, err = readBody(resp)
+	}
+	if err != nil {
+		observe(nil, nil, err)
+		return nil, 0, newRequestError(req.Method, req.URL.String(), classifyTransportError(err))
+	}
+	observe(resp, respBytes, nil)
+
+	if debugWriter != nil {
+		dumpResponse(debugWriter, resp, respBytes)
+	}
+
+	c.observeRateLimit(getAction(req.URL.String()), resp)
+
+	err = checkResponseStatus(req, resp, respBytes)
+	if err == nil && w != nil {
+		err = newHTTPStatusError(req, resp, respBytes)
+	}
 	if err != nil {
-		return nil, err
+		return nil, 0, newRequestError(req.Method, req.URL.String(), err)
+	}
+
+	if !json.Valid(respBytes) {
+		var raw json.RawMessage
+		err = json.Unmarshal(respBytes, &raw)
+		return nil, 0, newRequestError(req.Method, req.URL.String(), err)
+	}
+
+	return respBytes, resp.StatusCode, nil
+}
+
+// readBody reads the body of resp into a buffer sized after its Content-Length, when known.
+func readBody(resp *http.Response) ([]byte, error) {
+	if resp.ContentLength <= 0 {
+		return ioutil.ReadAll(resp.Body)
 	}
 
-	return respByte, nil
+	buf := bytes.NewBuffer(make([]byte, 0, resp.ContentLength+bytes.MinRead))
+	_, err := buf.ReadFrom(resp.Body)
+	return buf.Bytes(), err
 }
 
 // modifyUser is an encapsulation of user CUD(Create, Update, Delete) operations.
 // possible actions are `add-user`, `update-user`, `delete-user`,
-func modifyUser(action string, user *User, columns []string) (*Response, bool, error) {
-	return modifyUserById(action, user.GetId(), user, columns)
+func (c *Client) modifyUser(ctx context.Context, action string, user *User, columns []string) (*Response, bool, error) {
+	return c.modifyUserById(ctx, action, user.GetId(), user, columns)
 }
 
-func modifyUserById(action string, id string, user *User, columns []string) (*Response, bool, error) {
+func (c *Client) modifyUserById(ctx context.Context, action string, id string, user *User, columns []string) (*Response, bool, error) {
+	authConfig := c.getAuthConfig()
+
 	queryMap := map[string]string{
 		"id": id,
 	}
@@ -213,17 +417,19 @@ func modifyUserById(action string, id string, user *User, columns []string) (*Re
 		return nil, false, err
 	}
 
-	resp, err := DoPost(action, queryMap, postBytes, false, false)
+	resp, err := c.DoPostWithContext(ctx, action, queryMap, postBytes, false, false)
 	if err != nil {
 		return nil, false, err
 	}
 
-	return resp, resp.Data == "Affected", nil
+	return resp, isAffected(resp), nil
 }
 
 // modifyPermission is an encapsulation of permission CUD(Create, Update, Delete) operations.
 // possible actions are `add-permission`, `update-permission`, `delete-permission`,
-func modifyPermission(action string, permission *Permission, columns []string) (*Response, bool, error) {
+func (c *Client) modifyPermission(ctx context.Context, action string, permission *Permission, columns []string) (*Response, bool, error) {
+	authConfig := c.getAuthConfig()
+
 	queryMap := map[string]string{
 		"id": fmt.Sprintf("%s/%s", permission.Owner, permission.Name),
 	}
@@ -238,17 +444,19 @@ func modifyPermission(action string, permission *Permission, columns []string) (
 		return nil, false, err
 	}
 
-	resp, err := DoPost(action, queryMap, postBytes, false, false)
+	resp, err := c.DoPostWithContext(ctx, action, queryMap, postBytes, false, false)
 	if err != nil {
 		return nil, false, err
 	}
 
-	return resp, resp.Data == "Affected", nil
+	return resp, isAffected(resp), nil
 }
 
 // modifyRole is an encapsulation of role CUD(Create, Update, Delete) operations.
 // possible actions are `add-role`, `update-role`, `delete-role`,
-func modifyRole(action string, role *Role, columns []string) (*Response, bool, error) {
+func (c *Client) modifyRole(ctx context.Context, action string, role *Role, columns []string) (*Response, bool, error) {
+	authConfig := c.getAuthConfig()
+
 	queryMap := map[string]string{
 		"id": fmt.Sprintf("%s/%s", role.Owner, role.Name),
 	}
@@ -263,10 +471,372 @@ func modifyRole(action string, role *Role, columns []string) (*Response, bool, e
 		return nil, false, err
 	}
 
-	resp, err := DoPost(action, queryMap, postBytes, false, false)
+	resp, err := c.DoPostWithContext(ctx, action, queryMap, postBytes, false, false)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return resp, isAffected(resp), nil
+}
+
+// modifyProvider is an encapsulation of provider CUD(Create, Update, Delete) operations.
+// The providers are global objects, owned by "admin" unless the Owner of provider is set.
+func (c *Client) modifyProvider(ctx context.Context, action string, provider *Provider) (*Response, bool, error) {
+	if provider.Owner == "" {
+		provider.Owner = "admin"
+	}
+	queryMap := map[string]string{
+		"id": fmt.Sprintf("%s/%s", provider.Owner, provider.Name),
+	}
+
+	postBytes, err := json.Marshal(provider)
+	if err != nil {
+		return nil, false, err
+	}
+
+	resp, err := c.DoPostWithContext(ctx, action, queryMap, postBytes, false, false)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return resp, isAffected(resp), nil
+}
+
+// modifyCert is an encapsulation of cert CUD(Create, Update, Delete) operations.
+// The certs are global objects, owned by "admin" unless the Owner of cert is set.
+func (c *Client) modifyCert(ctx context.Context, action string, cert *Cert) (*Response, bool, error) {
+	if cert.Owner == "" {
+		cert.Owner = "admin"
+	}
+	queryMap := map[string]string{
+		"id": fmt.Sprintf("%s/%s", cert.Owner, cert.Name),
+	}
+
+	postBytes, err := json.Marshal(cert)
+	if err != nil {
+		return nil, false, err
+	}
+
+	resp, err := c.DoPostWithContext(ctx, action, queryMap, postBytes, false, false)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return resp, isAffected(resp), nil
+}
+
+// modifyWebhook is an encapsulation of webhook CUD(Create, Update, Delete) operations.
+// The webhooks are owned by "admin", and belong to the configured organization unless their Organization is set.
+func (c *Client) modifyWebhook(ctx context.Context, action string, webhook *Webhook) (*Response, bool, error) {
+	authConfig := c.getAuthConfig()
+
+	if webhook.Owner == "" {
+		webhook.Owner = "admin"
+	}
+	if webhook.Organization == "" {
+		webhook.Organization = authConfig.OrganizationName
+	}
+	queryMap := map[string]string{
+		"id": fmt.Sprintf("%s/%s", webhook.Owner, webhook.Name),
+	}
+
+	postBytes, err := json.Marshal(webhook)
+	if err != nil {
+		return nil, false, err
+	}
+
+	resp, err := c.DoPostWithContext(ctx, action, queryMap, postBytes, false, false)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return resp, isAffected(resp), nil
+}
+
+// modifyGroup is an encapsulation of group CUD(Create, Update, Delete) operations.
+func (c *Client) modifyGroup(ctx context.Context, action string, group *Group, columns []string) (*Response, bool, error) {
+	authConfig := c.getAuthConfig()
+
+	queryMap := map[string]string{
+		"id": fmt.Sprintf("%s/%s", group.Owner, group.Name),
+	}
+
+	if len(columns) != 0 {
+		queryMap["columns"] = strings.Join(columns, ",")
+	}
+
+	group.Owner = authConfig.OrganizationName
+	postBytes, err := json.Marshal(group)
+	if err != nil {
+		return nil, false, err
+	}
+
+	resp, err := c.DoPostWithContext(ctx, action, queryMap, postBytes, false, false)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return resp, isAffected(resp), nil
+}
+
+// modifyProduct is an encapsulation of product CUD(Create, Update, Delete) operations.
+func (c *Client) modifyProduct(ctx context.Context, action string, product *Product, columns []string) (*Response, bool, error) {
+	authConfig := c.getAuthConfig()
+
+	queryMap := map[string]string{
+		"id": fmt.Sprintf("%s/%s", product.Owner, product.Name),
+	}
+
+	if len(columns) != 0 {
+		queryMap["columns"] = strings.Join(columns, ",")
+	}
+
+	product.Owner = authConfig.OrganizationName
+	postBytes, err := json.Marshal(product)
+	if err != nil {
+		return nil, false, err
+	}
+
+	resp, err := c.DoPostWithContext(ctx, action, queryMap, postBytes, false, false)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return resp, isAffected(resp), nil
+}
+
+// modifyPayment is an encapsulation of payment CUD(Create, Update, Delete) operations.
+func (c *Client) modifyPayment(ctx context.Context, action string, payment *Payment, columns []string) (*Response, bool, error) {
+	authConfig := c.getAuthConfig()
+
+	queryMap := map[string]string{
+		"id": fmt.Sprintf("%s/%s", payment.Owner, payment.Name),
+	}
+
+	if len(columns) != 0 {
+		queryMap["columns"] = strings.Join(columns, ",")
+	}
+
+	payment.Owner = authConfig.OrganizationName
+	postBytes, err := json.Marshal(payment)
+	if err != nil {
+		return nil, false, err
+	}
+
+	resp, err := c.DoPostWithContext(ctx, action, queryMap, postBytes, false, false)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return resp, isAffected(resp), nil
+}
+
+// modifyPlan is an encapsulation of plan CUD(Create, Update, Delete) operations.
+func (c *Client) modifyPlan(ctx context.Context, action string, plan *Plan, columns []string) (*Response, bool, error) {
+	authConfig := c.getAuthConfig()
+
+	queryMap := map[string]string{
+		"id": fmt.Sprintf("%s/%s", plan.Owner, plan.Name),
+	}
+
+	if len(columns) != 0 {
+		queryMap["columns"] = strings.Join(columns, ",")
+	}
+
+	plan.Owner = authConfig.OrganizationName
+	postBytes, err := json.Marshal(plan)
+	if err != nil {
+		return nil, false, err
+	}
+
+	resp, err := c.DoPostWithContext(ctx, action, queryMap, postBytes, false, false)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return resp, isAffected(resp), nil
+}
+
+// modifyPricing is an encapsulation of pricing CUD(Create, Update, Delete) operations.
+func (c *Client) modifyPricing(ctx context.Context, action string, pricing *Pricing, columns []string) (*Response, bool, error) {
+	authConfig := c.getAuthConfig()
+
+	queryMap := map[string]string{
+		"id": fmt.Sprintf("%s/%s", pricing.Owner, pricing.Name),
+	}
+
+	if len(columns) != 0 {
+		queryMap["columns"] = strings.Join(columns, ",")
+	}
+
+	pricing.Owner = authConfig.OrganizationName
+	postBytes, err := json.Marshal(pricing)
+	if err != nil {
+		return nil, false, err
+	}
+
+	resp, err := c.DoPostWithContext(ctx, action, queryMap, postBytes, false, false)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return resp, isAffected(resp), nil
+}
+
+// modifySubscription is an encapsulation of subscription CUD(Create, Update, Delete) operations.
+func (c *Client) modifySubscription(ctx context.Context, action string, subscription *Subscription, columns []string) (*Response, bool, error) {
+	authConfig := c.getAuthConfig()
+
+	queryMap := map[string]string{
+		"id": fmt.Sprintf("%s/%s", subscription.Owner, subscription.Name),
+	}
+
+	if len(columns) != 0 {
+		queryMap["columns"] = strings.Join(columns, ",")
+	}
+
+	subscription.Owner = authConfig.OrganizationName
+	postBytes, err := json.Marshal(subscription)
+	if err != nil {
+		return nil, false, err
+	}
+
+	resp, err := c.DoPostWithContext(ctx, action, queryMap, postBytes, false, false)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return resp, isAffected(resp), nil
+}
+
+// modifySyncer is an encapsulation of syncer CUD(Create, Update, Delete) operations.
+// The syncers are owned by "admin", and belong to the configured organization unless their Organization is set.
+func (c *Client) modifySyncer(ctx context.Context, action string, syncer *Syncer) (*Response, bool, error) {
+	authConfig := c.getAuthConfig()
+
+	if syncer.Owner == "" {
+		syncer.Owner = "admin"
+	}
+	if syncer.Organization == "" {
+		syncer.Organization = authConfig.OrganizationName
+	}
+	queryMap := map[string]string{
+		"id": fmt.Sprintf("%s/%s", syncer.Owner, syncer.Name),
+	}
+
+	postBytes, err := json.Marshal(syncer)
+	if err != nil {
+		return nil, false, err
+	}
+
+	resp, err := c.DoPostWithContext(ctx, action, queryMap, postBytes, false, false)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return resp, isAffected(resp), nil
+}
+
+// modifyAdapter is an encapsulation of adapter CUD(Create, Update, Delete) operations.
+func (c *Client) modifyAdapter(ctx context.Context, action string, adapter *Adapter, columns []string) (*Response, bool, error) {
+	authConfig := c.getAuthConfig()
+
+	queryMap := map[string]string{
+		"id": fmt.Sprintf("%s/%s", adapter.Owner, adapter.Name),
+	}
+
+	if len(columns) != 0 {
+		queryMap["columns"] = strings.Join(columns, ",")
+	}
+
+	adapter.Owner = authConfig.OrganizationName
+	postBytes, err := json.Marshal(adapter)
+	if err != nil {
+		return nil, false, err
+	}
+
+	resp, err := c.DoPostWithContext(ctx, action, queryMap, postBytes, false, false)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return resp, isAffected(resp), nil
+}
+
+// modifyModel is an encapsulation of model CUD(Create, Update, Delete) operations.
+func (c *Client) modifyModel(ctx context.Context, action string, model *Model, columns []string) (*Response, bool, error) {
+	authConfig := c.getAuthConfig()
+
+	queryMap := map[string]string{
+		"id": fmt.Sprintf("%s/%s", model.Owner, model.Name),
+	}
+
+	if len(columns) != 0 {
+		queryMap["columns"] = strings.Join(columns, ",")
+	}
+
+	model.Owner = authConfig.OrganizationName
+	postBytes, err := json.Marshal(model)
+	if err != nil {
+		return nil, false, err
+	}
+
+	resp, err := c.DoPostWithContext(ctx, action, queryMap, postBytes, false, false)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return resp, isAffected(resp), nil
+}
+
+// modifyEnforcer is an encapsulation of enforcer CUD(Create, Update, Delete) operations.
+func (c *Client) modifyEnforcer(ctx context.Context, action string, enforcer *Enforcer, columns []string) (*Response, bool, error) {
+	authConfig := c.getAuthConfig()
+
+	queryMap := map[string]string{
+		"id": fmt.Sprintf("%s/%s", enforcer.Owner, enforcer.Name),
+	}
+
+	if len(columns) != 0 {
+		queryMap["columns"] = strings.Join(columns, ",")
+	}
+
+	enforcer.Owner = authConfig.OrganizationName
+	postBytes, err := json.Marshal(enforcer)
+	if err != nil {
+		return nil, false, err
+	}
+
+	resp, err := c.DoPostWithContext(ctx, action, queryMap, postBytes, false, false)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return resp, isAffected(resp), nil
+}
+
+// modifyInvitation is an encapsulation of invitation CUD(Create, Update, Delete) operations.
+func (c *Client) modifyInvitation(ctx context.Context, action string, invitation *Invitation, columns []string) (*Response, bool, error) {
+	authConfig := c.getAuthConfig()
+
+	queryMap := map[string]string{
+		"id": fmt.Sprintf("%s/%s", invitation.Owner, invitation.Name),
+	}
+
+	if len(columns) != 0 {
+		queryMap["columns"] = strings.Join(columns, ",")
+	}
+
+	invitation.Owner = authConfig.OrganizationName
+	postBytes, err := json.Marshal(invitation)
+	if err != nil {
+		return nil, false, err
+	}
+
+	resp, err := c.DoPostWithContext(ctx, action, queryMap, postBytes, false, false)
 	if err != nil {
 		return nil, false, err
 	}
 
-	return resp, resp.Data == "Affected", nil
+	return resp, isAffected(resp), nil
 }
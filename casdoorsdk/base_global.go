@@ -0,0 +1,72 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import (
+	"context"
+	"io"
+)
+
+func SetHttpClient(httpClient HttpClient) {
+	globalClient.SetHttpClient(httpClient)
+}
+
+func SetStrictDecoding(strict bool) {
+	globalClient.SetStrictDecoding(strict)
+}
+
+func SetLanguage(lang string) {
+	globalClient.SetLanguage(lang)
+}
+
+func DoGetResponse(url string) (*Response, error) {
+	return globalClient.DoGetResponse(url)
+}
+
+func DoGetResponseWithContext(ctx context.Context, url string) (*Response, error) {
+	return globalClient.DoGetResponseWithContext(ctx, url)
+}
+
+func DoGetBytes(url string) ([]byte, error) {
+	return globalClient.DoGetBytes(url)
+}
+
+func DoGetBytesWithContext(ctx context.Context, url string) ([]byte, error) {
+	return globalClient.DoGetBytesWithContext(ctx, url)
+}
+
+func DoGetBytesRaw(url string) ([]byte, error) {
+	return globalClient.DoGetBytesRaw(url)
+}
+
+func DoGetBytesRawWithContext(ctx context.Context, url string) ([]byte, error) {
+	return globalClient.DoGetBytesRawWithContext(ctx, url)
+}
+
+func DoPost(action string, queryMap map[string]string, postBytes []byte, isForm, isFile bool) (*Response, error) {
+	return globalClient.DoPost(action, queryMap, postBytes, isForm, isFile)
+}
+
+func DoPostWithContext(ctx context.Context, action string, queryMap map[string]string, postBytes []byte, isForm, isFile bool) (*Response, error) {
+	return globalClient.DoPostWithContext(ctx, action, queryMap, postBytes, isForm, isFile)
+}
+
+func DoPostBytesRaw(url string, contentType string, body io.Reader) ([]byte, error) {
+	return globalClient.DoPostBytesRaw(url, contentType, body)
+}
+
+func DoPostBytesRawWithContext(ctx context.Context, url string, contentType string, body io.Reader) ([]byte, error) {
+	return globalClient.DoPostBytesRawWithContext(ctx, url, contentType, body)
+}
@@ -0,0 +1,256 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// ErrNotAffected is reported by a Batcher for an operation that succeeded without changing anything,
+// e.g. the deletion of an object that doesn't exist.
+var ErrNotAffected = errors.New("casdoor: the operation didn't affect any object")
+
+const (
+	defaultBatchConcurrency = 4
+	defaultBatchRetryDelay  = time.Second
+	defaultUserBatchSize    = 100
+)
+
+// BatchOptions configures a Batcher.
+type BatchOptions struct {
+	// Concurrency is the number of operations run at the same time, 4 by default.
+	Concurrency int
+	// MaxRetries is the number of times an operation is retried after a retryable error, see IsRetryable.
+	// These retries are on top of the ones of the client, see SetRetryOptions: each attempt of an operation
+	// sends its request up to RetryOptions.MaxAttempts times, so only one of the two should usually be set.
+	MaxRetries int
+	// RetryDelay is the delay before the first retry, doubled at each retry, 1s by default.
+	// A Retry-After sent by the server takes precedence.
+	RetryDelay time.Duration
+}
+
+// BatchResult is the outcome of an operation of a Batcher.
+type BatchResult struct {
+	Name     string
+	Attempts int
+	// Err is nil if the operation succeeded. It is ErrNotAffected if the operation didn't change anything.
+	Err error
+}
+
+// BatchReport is the outcome of the operations of a Batcher, in the order they were added.
+type BatchReport struct {
+	Results []*BatchResult
+}
+
+// Succeeded returns the results of the operations that succeeded.
+func (r *BatchReport) Succeeded() []*BatchResult {
+	var res []*BatchResult
+	for _, result := range r.Results {
+		if result.Err == nil {
+			res = append(res, result)
+		}
+	}
+	return res
+}
+
+// Failed returns the results of the operations that failed.
+func (r *BatchReport) Failed() []*BatchResult {
+	var res []*BatchResult
+	for _, result := range r.Results {
+		if result.Err != nil {
+			res = append(res, result)
+		}
+	}
+	return res
+}
+
+// Err returns an error summing up the failed operations, or nil if they all succeeded.
+func (r *BatchReport) Err() error {
+	failed := r.Failed()
+	if len(failed) == 0 {
+		return nil
+	}
+	return fmt.Errorf("casdoor: %d of %d operations failed, first %s: %w", len(failed), len(r.Results), failed[0].Name, failed[0].Err)
+}
+
+type batchOperation struct {
+	name string
+	do   func(ctx context.Context) (bool, error)
+}
+
+// Batcher runs many operations, like adding or deleting users, with bounded concurrency and retries,
+// and reports the outcome of each of them instead of stopping at the first failure.
+type Batcher struct {
+	client     *Client
+	options    BatchOptions
+	operations []*batchOperation
+}
+
+// NewBatcher returns a Batcher configured with options, which can be nil.
+func (c *Client) NewBatcher(options *BatchOptions) *Batcher {
+	b := &Batcher{client: c}
+	if options != nil {
+		b.options = *options
+	}
+	if b.options.Concurrency <= 0 {
+		b.options.Concurrency = defaultBatchConcurrency
+	}
+	if b.options.RetryDelay <= 0 {
+		b.options.RetryDelay = defaultBatchRetryDelay
+	}
+	return b
+}
+
+// Add adds an operation named name, which is a function with the signature of the SDK functions modifying objects,
+// called with the context given to Run, like:
+//
+//	b.Add("add-user "+user.Name, func(ctx context.Context) (bool, error) { return client.AddUserWithContext(ctx, user) })
+func (b *Batcher) Add(name string, do func(ctx context.Context) (bool, error)) {
+	b.operations = append(b.operations, &batchOperation{name: name, do: do})
+}
+
+func (b *Batcher) AddUser(user *User) {
+	b.Add(fmt.Sprintf("add-user %s/%s", user.Owner, user.Name), func(ctx context.Context) (bool, error) { return b.client.AddUserWithContext(ctx, user) })
+}
+
+func (b *Batcher) UpdateUser(user *User) {
+	b.Add(fmt.Sprintf("update-user %s/%s", user.Owner, user.Name), func(ctx context.Context) (bool, error) { return b.client.UpdateUserWithContext(ctx, user) })
+}
+
+func (b *Batcher) DeleteUser(user *User) {
+	b.Add(fmt.Sprintf("delete-user %s/%s", user.Owner, user.Name), func(ctx context.Context) (bool, error) { return b.client.DeleteUserWithContext(ctx, user) })
+}
+
+func (b *Batcher) AddRole(role *Role) {
+	b.Add(fmt.Sprintf("add-role %s/%s", role.Owner, role.Name), func(ctx context.Context) (bool, error) { return b.client.AddRoleWithContext(ctx, role) })
+}
+
+func (b *Batcher) UpdateRole(role *Role) {
+	b.Add(fmt.Sprintf("update-role %s/%s", role.Owner, role.Name), func(ctx context.Context) (bool, error) { return b.client.UpdateRoleWithContext(ctx, role) })
+}
+
+func (b *Batcher) DeleteRole(role *Role) {
+	b.Add(fmt.Sprintf("delete-role %s/%s", role.Owner, role.Name), func(ctx context.Context) (bool, error) { return b.client.DeleteRoleWithContext(ctx, role) })
+}
+
+func (b *Batcher) AddPermission(permission *Permission) {
+	b.Add(fmt.Sprintf("add-permission %s/%s", permission.Owner, permission.Name), func(ctx context.Context) (bool, error) { return b.client.AddPermissionWithContext(ctx, permission) })
+}
+
+func (b *Batcher) UpdatePermission(permission *Permission) {
+	b.Add(fmt.Sprintf("update-permission %s/%s", permission.Owner, permission.Name), func(ctx context.Context) (bool, error) { return b.client.UpdatePermissionWithContext(ctx, permission) })
+}
+
+func (b *Batcher) DeletePermission(permission *Permission) {
+	b.Add(fmt.Sprintf("delete-permission %s/%s", permission.Owner, permission.Name), func(ctx context.Context) (bool, error) { return b.client.DeletePermissionWithContext(ctx, permission) })
+}
+
+// AddUsers adds an operation adding users with a single request, see Client.AddUsers.
+func (b *Batcher) AddUsers(users []*User) {
+	name := "add-users"
+	if len(users) != 0 {
+		name = fmt.Sprintf("add-users %s..%s", users[0].Name, users[len(users)-1].Name)
+	}
+	b.Add(name, func(ctx context.Context) (bool, error) { return b.client.AddUsersWithContext(ctx, users) })
+}
+
+// Len returns the number of operations added.
+func (b *Batcher) Len() int {
+	return len(b.operations)
+}
+
+// Run runs the operations and returns their outcome. The operations that haven't started
+// when ctx is done fail with the error of ctx.
+func (b *Batcher) Run(ctx context.Context) *BatchReport {
+	report := &BatchReport{Results: make([]*BatchResult, len(b.operations))}
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, b.options.Concurrency)
+	for i, operation := range b.operations {
+		report.Results[i] = &BatchResult{Name: operation.name}
+
+		select {
+		case <-ctx.Done():
+			report.Results[i].Err = ctx.Err()
+			continue
+		case sem <- struct{}{}:
+		}
+
+		wg.Add(1)
+		go func(operation *batchOperation, result *BatchResult) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			b.runOperation(ctx, operation, result)
+		}(operation, report.Results[i])
+	}
+
+	wg.Wait()
+	return report
+}
+
+// runOperation runs operation into result, retrying it after retryable errors.
+func (b *Batcher) runOperation(ctx context.Context, operation *batchOperation, result *BatchResult) {
+	delay := b.options.RetryDelay
+	for {
+		result.Attempts++
+
+		affected, err := operation.do(ctx)
+		if err == nil && !affected {
+			err = ErrNotAffected
+		}
+		result.Err = err
+		if err == nil || !IsRetryable(err) || result.Attempts > b.options.MaxRetries {
+			return
+		}
+
+		wait := delay
+		if retryAfter, ok := GetRetryAfter(err); ok {
+			wait = retryAfter
+		}
+		delay *= 2
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+	}
+}
+
+// AddUsersInBatches adds users to the organization with one AddUsers request per batch of batchSize users
+// (100 if batchSize <= 0), run with the concurrency and retries of options, which can be nil.
+// A failed batch doesn't stop the others: the report has a result per batch, to log or retry the failed ones.
+func (c *Client) AddUsersInBatches(ctx context.Context, users []*User, batchSize int, options *BatchOptions) *BatchReport {
+	if batchSize <= 0 {
+		batchSize = defaultUserBatchSize
+	}
+
+	b := c.NewBatcher(options)
+	for start := 0; start < len(users); start += batchSize {
+		end := start + batchSize
+		if end > len(users) {
+			end = len(users)
+		}
+		b.AddUsers(users[start:end])
+	}
+	return b.Run(ctx)
+}
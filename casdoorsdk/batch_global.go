@@ -0,0 +1,25 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import "context"
+
+func NewBatcher(options *BatchOptions) *Batcher {
+	return globalClient.NewBatcher(options)
+}
+
+func AddUsersInBatches(ctx context.Context, users []*User, batchSize int, options *BatchOptions) *BatchReport {
+	return globalClient.AddUsersInBatches(ctx, users, batchSize, options)
+}
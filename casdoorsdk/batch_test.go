@@ -0,0 +1,61 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+type batchTestKey struct{}
+
+func TestBatcherPassesTheContextOfRun(t *testing.T) {
+	client := NewClient(&AuthConfig{})
+	b := client.NewBatcher(&BatchOptions{MaxRetries: 2})
+
+	ctx := context.WithValue(context.Background(), batchTestKey{}, "run")
+	b.Add("check-context", func(ctx context.Context) (bool, error) {
+		if ctx.Value(batchTestKey{}) != "run" {
+			return false, errors.New("the operation didn't get the context of Run")
+		}
+		return true, nil
+	})
+
+	err := b.Run(ctx).Err()
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestBatcherRetries(t *testing.T) {
+	client := NewClient(&AuthConfig{})
+	b := client.NewBatcher(&BatchOptions{MaxRetries: 2, RetryDelay: 1})
+
+	b.Add("fail", func(ctx context.Context) (bool, error) {
+		return false, errServerFailing
+	})
+	b.Add("not-affected", func(ctx context.Context) (bool, error) {
+		return false, nil
+	})
+
+	report := b.Run(context.Background())
+	if result := report.Results[0]; result.Attempts != 3 || !errors.Is(result.Err, errServerFailing) {
+		t.Errorf("the failing operation got %d attempts and err %v, want 3 and the failure", result.Attempts, result.Err)
+	}
+	if result := report.Results[1]; result.Attempts != 1 || !errors.Is(result.Err, ErrNotAffected) {
+		t.Errorf("the operation affecting nothing got %d attempts and err %v, want 1 and ErrNotAffected", result.Attempts, result.Err)
+	}
+}
@@ -0,0 +1,114 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+const benchmarkUser = `{"owner":"built-in","name":"alice","createdTime":"2023-01-01T00:00:00Z","id":"0f8e3c4a","type":"normal",` +
+	`"displayName":"Alice","avatar":"https://example.com/alice.png","email":"alice@example.com","phone":"12345678",` +
+	`"region":"US","language":"en","score":2000,"isAdmin":false,"isForbidden":false,"signupApplication":"app-built-in",` +
+	`"properties":{"team":"platform"},"someFieldOfANewerServer":"value"}`
+
+func BenchmarkGetUrl(b *testing.B) {
+	InitConfig("https://door.example.com", "client-id", "client-secret", "", "built-in", "app-built-in")
+	queryMap := map[string]string{"owner": "built-in", "p": "1", "pageSize": "50", "field": "name", "value": "alice"}
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = GetUrl("get-users", queryMap)
+	}
+}
+
+func BenchmarkUnmarshalUser(b *testing.B) {
+	data := []byte(benchmarkUser)
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		var user User
+		err := json.Unmarshal(data, &user)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkGetUser(b *testing.B) {
+	body := []byte(benchmarkUser)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(body)
+	}))
+	defer server.Close()
+	InitConfig(server.URL, "client-id", "client-secret", "", "built-in", "app-built-in")
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, err := GetUser("alice")
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkParseJwtToken(b *testing.B) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		b.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "casdoor"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	certificate, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		b.Fatal(err)
+	}
+	certificatePem := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certificate})
+	InitConfig("https://door.example.com", "client-id", "client-secret", string(certificatePem), "built-in", "app-built-in")
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+		"owner": "built-in",
+		"name":  "alice",
+		"exp":   time.Now().Add(time.Hour).Unix(),
+	}).SignedString(key)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, err := ParseJwtToken(token)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
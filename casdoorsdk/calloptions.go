@@ -0,0 +1,91 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+// CallOption customizes the requests of a single call. The methods transferring files, like UploadResource and
+// DownloadResource, accept them as their last arguments, and WithCallOptions passes them to any call through its context.
+type CallOption func(options *callOptions)
+
+type callOptions struct {
+	timeout time.Duration
+	header  http.Header
+}
+
+type callOptionsKey struct{}
+
+// WithCallOptions returns a copy of ctx carrying options, which apply to the calls made with it, e.g.
+//
+//	ctx = casdoorsdk.WithCallOptions(ctx, casdoorsdk.WithTimeout(5*time.Minute))
+//	fileUrl, name, err := client.UploadResourceStreamWithContext(ctx, fullFilePath, file, size, nil)
+//
+// The options are added to the ones already carried by ctx.
+func WithCallOptions(ctx context.Context, options ...CallOption) context.Context {
+	callOptions := getCallOptions(ctx)
+	callOptions.header = callOptions.header.Clone()
+	for _, option := range options {
+		option(&callOptions)
+	}
+	return context.WithValue(ctx, callOptionsKey{}, callOptions)
+}
+
+// WithTimeout limits the duration of the call, including its retries, regardless of the timeouts of the other calls.
+// It can't extend the deadline of the context, nor the Timeout of the http.Client, which applies to every request:
+// the default client has none, and a custom client serving calls of different durations, like uploads and lookups,
+// should leave it unset and rely on WithTimeout instead.
+func WithTimeout(timeout time.Duration) CallOption {
+	return func(options *callOptions) {
+		options.timeout = timeout
+	}
+}
+
+// WithHeader sets a header of the requests of the call.
+func WithHeader(key string, value string) CallOption {
+	return func(options *callOptions) {
+		if options.header == nil {
+			options.header = http.Header{}
+		}
+		options.header.Set(key, value)
+	}
+}
+
+func getCallOptions(ctx context.Context) callOptions {
+	options, _ := ctx.Value(callOptionsKey{}).(callOptions)
+	return options
+}
+
+// applyCallTimeout applies the timeout of the call options of the context of req to it. The returned function
+// releases the resources of the timeout once the call is done.
+func applyCallTimeout(req *http.Request) (*http.Request, context.CancelFunc) {
+	options := getCallOptions(req.Context())
+	if options.timeout <= 0 {
+		return req, func() {}
+	}
+	ctx, cancel := context.WithTimeout(req.Context(), options.timeout)
+	return req.WithContext(ctx), cancel
+}
+
+// applyCallHeaders sets the headers of the call options of the context of req on it, over the ones already set.
+func applyCallHeaders(req *http.Request) {
+	options := getCallOptions(req.Context())
+	for key, values := range options.header {
+		req.Header[key] = values
+	}
+}
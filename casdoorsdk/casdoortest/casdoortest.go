@@ -0,0 +1,99 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package casdoortest helps to unit test the applications using the SDK without a Casdoor server.
+//
+// The applications depend on the Client interface, or on a subset of it, instead of *casdoorsdk.Client,
+// which implements it, and are given a Fake, which stores the users, roles and permissions in memory,
+// or a Mock in the tests:
+//
+//	fake := casdoortest.NewFake("built-in")
+//	fake.AddUser(&casdoorsdk.User{Name: "alice", Password: "123"})
+//	token := fake.IssueToken("alice")
+//
+//	handler := NewHandler(fake)
+//
+// The integration tests can run the SDK itself against a Server, a local server emulating the main endpoints
+// of Casdoor.
+package casdoortest
+
+import (
+	"context"
+
+	"github.com/casdoor/casdoor-go-sdk/casdoorsdk"
+)
+
+// Client is the part of the SDK the applications usually call: the users, roles and permissions,
+// the enforcement of the permissions and the parsing of the tokens.
+type Client interface {
+	GetUsers() ([]*casdoorsdk.User, error)
+	GetUsersWithContext(ctx context.Context) ([]*casdoorsdk.User, error)
+	GetUser(name string) (*casdoorsdk.User, error)
+	GetUserWithContext(ctx context.Context, name string) (*casdoorsdk.User, error)
+	GetUserByEmail(email string) (*casdoorsdk.User, error)
+	GetUserByEmailWithContext(ctx context.Context, email string) (*casdoorsdk.User, error)
+	GetUserByPhone(phone string) (*casdoorsdk.User, error)
+	GetUserByPhoneWithContext(ctx context.Context, phone string) (*casdoorsdk.User, error)
+	GetUserByUserId(userId string) (*casdoorsdk.User, error)
+	GetUserByUserIdWithContext(ctx context.Context, userId string) (*casdoorsdk.User, error)
+	AddUser(user *casdoorsdk.User) (bool, error)
+	AddUserWithContext(ctx context.Context, user *casdoorsdk.User) (bool, error)
+	UpdateUser(user *casdoorsdk.User) (bool, error)
+	UpdateUserWithContext(ctx context.Context, user *casdoorsdk.User) (bool, error)
+	DeleteUser(user *casdoorsdk.User) (bool, error)
+	DeleteUserWithContext(ctx context.Context, user *casdoorsdk.User) (bool, error)
+	CheckUserPassword(user *casdoorsdk.User) (bool, error)
+	CheckUserPasswordWithContext(ctx context.Context, user *casdoorsdk.User) (bool, error)
+	SetPassword(owner, name, oldPassword, newPassword string) (bool, error)
+	SetPasswordWithContext(ctx context.Context, owner, name, oldPassword, newPassword string) (bool, error)
+
+	GetRoles() ([]*casdoorsdk.Role, error)
+	GetRolesWithContext(ctx context.Context) ([]*casdoorsdk.Role, error)
+	GetRole(name string) (*casdoorsdk.Role, error)
+	GetRoleWithContext(ctx context.Context, name string) (*casdoorsdk.Role, error)
+	AddRole(role *casdoorsdk.Role) (bool, error)
+	AddRoleWithContext(ctx context.Context, role *casdoorsdk.Role) (bool, error)
+	UpdateRole(role *casdoorsdk.Role) (bool, error)
+	UpdateRoleWithContext(ctx context.Context, role *casdoorsdk.Role) (bool, error)
+	DeleteRole(role *casdoorsdk.Role) (bool, error)
+	DeleteRoleWithContext(ctx context.Context, role *casdoorsdk.Role) (bool, error)
+
+	GetPermissions() ([]*casdoorsdk.Permission, error)
+	GetPermissionsWithContext(ctx context.Context) ([]*casdoorsdk.Permission, error)
+	GetPermissionsByRole(name string) ([]*casdoorsdk.Permission, error)
+	GetPermissionsByRoleWithContext(ctx context.Context, name string) ([]*casdoorsdk.Permission, error)
+	GetPermission(name string) (*casdoorsdk.Permission, error)
+	GetPermissionWithContext(ctx context.Context, name string) (*casdoorsdk.Permission, error)
+	AddPermission(permission *casdoorsdk.Permission) (bool, error)
+	AddPermissionWithContext(ctx context.Context, permission *casdoorsdk.Permission) (bool, error)
+	UpdatePermission(permission *casdoorsdk.Permission) (bool, error)
+	UpdatePermissionWithContext(ctx context.Context, permission *casdoorsdk.Permission) (bool, error)
+	DeletePermission(permission *casdoorsdk.Permission) (bool, error)
+	DeletePermissionWithContext(ctx context.Context, permission *casdoorsdk.Permission) (bool, error)
+
+	Enforce(permissionId, modelId, resourceId string, casbinRequest casdoorsdk.CasbinRequest) (bool, error)
+	EnforceWithContext(ctx context.Context, permissionId, modelId, resourceId string, casbinRequest casdoorsdk.CasbinRequest) (bool, error)
+	BatchEnforce(permissionId, modelId, resourceId string, casbinRequests []casdoorsdk.CasbinRequest) ([][]bool, error)
+	BatchEnforceWithContext(ctx context.Context, permissionId, modelId, resourceId string, casbinRequests []casdoorsdk.CasbinRequest) ([][]bool, error)
+
+	ParseJwtToken(token string) (*casdoorsdk.Claims, error)
+}
+
+//go:generate go run ./internal/mockgen
+
+var (
+	_ Client = (*casdoorsdk.Client)(nil)
+	_ Client = (*Fake)(nil)
+	_ Client = (*Mock)(nil)
+)
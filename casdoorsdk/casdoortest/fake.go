@@ -0,0 +1,602 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoortest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/casdoor/casdoor-go-sdk/casdoorsdk"
+)
+
+// ErrInvalidToken is returned by Fake.ParseJwtToken for the tokens it didn't issue.
+var ErrInvalidToken = errors.New("casdoortest: invalid token")
+
+// Fake is an in-memory Client for the tests, storing the users, roles and permissions of an organization in maps.
+// The objects are copied in and out, so modifying them doesn't change the stored ones.
+// Its methods with a context fail with the error of the context once it is done. It is safe for concurrent use.
+type Fake struct {
+	organization string
+
+	mu          sync.RWMutex
+	users       map[string]*casdoorsdk.User
+	roles       map[string]*casdoorsdk.Role
+	permissions map[string]*casdoorsdk.Permission
+	tokens      map[string]*casdoorsdk.Claims
+}
+
+// NewFake returns an empty Fake of organization, the owner of the objects added without one.
+func NewFake(organization string) *Fake {
+	return &Fake{
+		organization: organization,
+		users:        map[string]*casdoorsdk.User{},
+		roles:        map[string]*casdoorsdk.Role{},
+		permissions:  map[string]*casdoorsdk.Permission{},
+		tokens:       map[string]*casdoorsdk.Claims{},
+	}
+}
+
+// IssueToken returns a token accepted by ParseJwtToken, whose claims hold the user named name as it is stored now.
+func (f *Fake) IssueToken(name string) string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	user := casdoorsdk.User{Owner: f.organization, Name: name}
+	if u, ok := f.users[name]; ok {
+		user = *u
+	}
+
+	token := fmt.Sprintf("casdoortest-token-%d", len(f.tokens)+1)
+	f.tokens[token] = &casdoorsdk.Claims{User: user, AccessToken: token}
+	return token
+}
+
+func (f *Fake) ParseJwtToken(token string) (*casdoorsdk.Claims, error) {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	claims, ok := f.tokens[token]
+	if !ok {
+		return nil, ErrInvalidToken
+	}
+	c := *claims
+	return &c, nil
+}
+
+func (f *Fake) GetUsers() ([]*casdoorsdk.User, error) {
+	return f.findUsers(func(user *casdoorsdk.User) bool { return true }), nil
+}
+
+func (f *Fake) GetUsersWithContext(ctx context.Context) ([]*casdoorsdk.User, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return f.GetUsers()
+}
+
+// GetUser returns the user named name, or nil if there is none, like the server.
+func (f *Fake) GetUser(name string) (*casdoorsdk.User, error) {
+	return f.findUser(func(user *casdoorsdk.User) bool { return user.Name == name }), nil
+}
+
+func (f *Fake) GetUserWithContext(ctx context.Context, name string) (*casdoorsdk.User, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return f.GetUser(name)
+}
+
+func (f *Fake) GetUserByEmail(email string) (*casdoorsdk.User, error) {
+	return f.findUser(func(user *casdoorsdk.User) bool { return user.Email == email }), nil
+}
+
+func (f *Fake) GetUserByEmailWithContext(ctx context.Context, email string) (*casdoorsdk.User, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return f.GetUserByEmail(email)
+}
+
+func (f *Fake) GetUserByPhone(phone string) (*casdoorsdk.User, error) {
+	return f.findUser(func(user *casdoorsdk.User) bool { return user.Phone == phone }), nil
+}
+
+func (f *Fake) GetUserByPhoneWithContext(ctx context.Context, phone string) (*casdoorsdk.User, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return f.GetUserByPhone(phone)
+}
+
+func (f *Fake) GetUserByUserId(userId string) (*casdoorsdk.User, error) {
+	return f.findUser(func(user *casdoorsdk.User) bool { return user.Id == userId }), nil
+}
+
+func (f *Fake) GetUserByUserIdWithContext(ctx context.Context, userId string) (*casdoorsdk.User, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return f.GetUserByUserId(userId)
+}
+
+// AddUser adds user, returning false if a user with the same name exists.
+func (f *Fake) AddUser(user *casdoorsdk.User) (bool, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if _, ok := f.users[user.Name]; ok {
+		return false, nil
+	}
+	u := *user
+	if u.Owner == "" {
+		u.Owner = f.organization
+	}
+	f.users[u.Name] = &u
+	return true, nil
+}
+
+func (f *Fake) AddUserWithContext(ctx context.Context, user *casdoorsdk.User) (bool, error) {
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+	return f.AddUser(user)
+}
+
+// UpdateUser replaces the user with the same name, returning false if there is none.
+func (f *Fake) UpdateUser(user *casdoorsdk.User) (bool, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if _, ok := f.users[user.Name]; !ok {
+		return false, nil
+	}
+	u := *user
+	if u.Owner == "" {
+		u.Owner = f.organization
+	}
+	f.users[u.Name] = &u
+	return true, nil
+}
+
+func (f *Fake) UpdateUserWithContext(ctx context.Context, user *casdoorsdk.User) (bool, error) {
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+	return f.UpdateUser(user)
+}
+
+func (f *Fake) DeleteUser(user *casdoorsdk.User) (bool, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if _, ok := f.users[user.Name]; !ok {
+		return false, nil
+	}
+	delete(f.users, user.Name)
+	return true, nil
+}
+
+func (f *Fake) DeleteUserWithContext(ctx context.Context, user *casdoorsdk.User) (bool, error) {
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+	return f.DeleteUser(user)
+}
+
+// CheckUserPassword reports whether user.Password is the Password of the stored user named user.Name.
+func (f *Fake) CheckUserPassword(user *casdoorsdk.User) (bool, error) {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	u, ok := f.users[user.Name]
+	if !ok {
+		return false, f.newUserNotExistError(user.Name)
+	}
+	return u.Password == user.Password, nil
+}
+
+func (f *Fake) CheckUserPasswordWithContext(ctx context.Context, user *casdoorsdk.User) (bool, error) {
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+	return f.CheckUserPassword(user)
+}
+
+// SetPassword sets the Password of the user named name, checking oldPassword if it isn't empty.
+func (f *Fake) SetPassword(owner, name, oldPassword, newPassword string) (bool, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	u, ok := f.users[name]
+	if !ok || u.Owner != owner {
+		return false, f.newUserNotExistError(name)
+	}
+	if oldPassword != "" && oldPassword != u.Password {
+		return false, &casdoorsdk.APIError{Code: casdoorsdk.ErrorCodeWrongPassword, Msg: "The old password is wrong"}
+	}
+
+	updated := *u
+	updated.Password = newPassword
+	f.users[name] = &updated
+	return true, nil
+}
+
+func (f *Fake) SetPasswordWithContext(ctx context.Context, owner, name, oldPassword, newPassword string) (bool, error) {
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+	return f.SetPassword(owner, name, oldPassword, newPassword)
+}
+
+func (f *Fake) newUserNotExistError(name string) error {
+	return &casdoorsdk.APIError{
+		Code: casdoorsdk.ErrorCodeUserNotExist,
+		Msg:  fmt.Sprintf("The user: %s/%s doesn't exist", f.organization, name),
+	}
+}
+
+func (f *Fake) findUsers(match func(user *casdoorsdk.User) bool) []*casdoorsdk.User {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	users := []*casdoorsdk.User{}
+	for _, user := range f.users {
+		if match(user) {
+			u := *user
+			users = append(users, &u)
+		}
+	}
+	sort.Slice(users, func(i, j int) bool { return users[i].Name < users[j].Name })
+	return users
+}
+
+func (f *Fake) findUser(match func(user *casdoorsdk.User) bool) *casdoorsdk.User {
+	users := f.findUsers(match)
+	if len(users) == 0 {
+		return nil
+	}
+	return users[0]
+}
+
+func (f *Fake) GetRoles() ([]*casdoorsdk.Role, error) {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	roles := []*casdoorsdk.Role{}
+	for _, role := range f.roles {
+		r := *role
+		roles = append(roles, &r)
+	}
+	sort.Slice(roles, func(i, j int) bool { return roles[i].Name < roles[j].Name })
+	return roles, nil
+}
+
+func (f *Fake) GetRolesWithContext(ctx context.Context) ([]*casdoorsdk.Role, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return f.GetRoles()
+}
+
+// GetRole returns the role named name, or nil if there is none.
+func (f *Fake) GetRole(name string) (*casdoorsdk.Role, error) {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	role, ok := f.roles[name]
+	if !ok {
+		return nil, nil
+	}
+	r := *role
+	return &r, nil
+}
+
+func (f *Fake) GetRoleWithContext(ctx context.Context, name string) (*casdoorsdk.Role, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return f.GetRole(name)
+}
+
+// AddRole adds role, returning false if a role with the same name exists.
+func (f *Fake) AddRole(role *casdoorsdk.Role) (bool, error) {
+	return f.putRole(role, false)
+}
+
+func (f *Fake) AddRoleWithContext(ctx context.Context, role *casdoorsdk.Role) (bool, error) {
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+	return f.AddRole(role)
+}
+
+// UpdateRole replaces the role with the same name, returning false if there is none.
+func (f *Fake) UpdateRole(role *casdoorsdk.Role) (bool, error) {
+	return f.putRole(role, true)
+}
+
+func (f *Fake) UpdateRoleWithContext(ctx context.Context, role *casdoorsdk.Role) (bool, error) {
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+	return f.UpdateRole(role)
+}
+
+func (f *Fake) putRole(role *casdoorsdk.Role, exists bool) (bool, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if _, ok := f.roles[role.Name]; ok != exists {
+		return false, nil
+	}
+	r := *role
+	if r.Owner == "" {
+		r.Owner = f.organization
+	}
+	f.roles[r.Name] = &r
+	return true, nil
+}
+
+func (f *Fake) DeleteRole(role *casdoorsdk.Role) (bool, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if _, ok := f.roles[role.Name]; !ok {
+		return false, nil
+	}
+	delete(f.roles, role.Name)
+	return true, nil
+}
+
+func (f *Fake) DeleteRoleWithContext(ctx context.Context, role *casdoorsdk.Role) (bool, error) {
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+	return f.DeleteRole(role)
+}
+
+func (f *Fake) GetPermissions() ([]*casdoorsdk.Permission, error) {
+	return f.findPermissions(func(permission *casdoorsdk.Permission) bool { return true }), nil
+}
+
+func (f *Fake) GetPermissionsWithContext(ctx context.Context) ([]*casdoorsdk.Permission, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return f.GetPermissions()
+}
+
+// GetPermissionsByRole returns the permissions granted to the role named name.
+func (f *Fake) GetPermissionsByRole(name string) ([]*casdoorsdk.Permission, error) {
+	roleId := fmt.Sprintf("%s/%s", f.organization, name)
+	return f.findPermissions(func(permission *casdoorsdk.Permission) bool {
+		return contains(permission.Roles, roleId)
+	}), nil
+}
+
+func (f *Fake) GetPermissionsByRoleWithContext(ctx context.Context, name string) ([]*casdoorsdk.Permission, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return f.GetPermissionsByRole(name)
+}
+
+// GetPermission returns the permission named name, or nil if there is none.
+func (f *Fake) GetPermission(name string) (*casdoorsdk.Permission, error) {
+	permissions := f.findPermissions(func(permission *casdoorsdk.Permission) bool { return permission.Name == name })
+	if len(permissions) == 0 {
+		return nil, nil
+	}
+	return permissions[0], nil
+}
+
+func (f *Fake) GetPermissionWithContext(ctx context.Context, name string) (*casdoorsdk.Permission, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return f.GetPermission(name)
+}
+
+// AddPermission adds permission, returning false if a permission with the same name exists.
+func (f *Fake) AddPermission(permission *casdoorsdk.Permission) (bool, error) {
+	return f.putPermission(permission, false)
+}
+
+func (f *Fake) AddPermissionWithContext(ctx context.Context, permission *casdoorsdk.Permission) (bool, error) {
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+	return f.AddPermission(permission)
+}
+
+// UpdatePermission replaces the permission with the same name, returning false if there is none.
+func (f *Fake) UpdatePermission(permission *casdoorsdk.Permission) (bool, error) {
+	return f.putPermission(permission, true)
+}
+
+func (f *Fake) UpdatePermissionWithContext(ctx context.Context, permission *casdoorsdk.Permission) (bool, error) {
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+	return f.UpdatePermission(permission)
+}
+
+func (f *Fake) putPermission(permission *casdoorsdk.Permission, exists bool) (bool, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if _, ok := f.permissions[permission.Name]; ok != exists {
+		return false, nil
+	}
+	p := *permission
+	if p.Owner == "" {
+		p.Owner = f.organization
+	}
+	f.permissions[p.Name] = &p
+	return true, nil
+}
+
+func (f *Fake) DeletePermission(permission *casdoorsdk.Permission) (bool, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if _, ok := f.permissions[permission.Name]; !ok {
+		return false, nil
+	}
+	delete(f.permissions, permission.Name)
+	return true, nil
+}
+
+func (f *Fake) DeletePermissionWithContext(ctx context.Context, permission *casdoorsdk.Permission) (bool, error) {
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+	return f.DeletePermission(permission)
+}
+
+func (f *Fake) findPermissions(match func(permission *casdoorsdk.Permission) bool) []*casdoorsdk.Permission {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	permissions := []*casdoorsdk.Permission{}
+	for _, permission := range f.permissions {
+		if match(permission) {
+			p := *permission
+			permissions = append(permissions, &p)
+		}
+	}
+	sort.Slice(permissions, func(i, j int) bool { return permissions[i].Name < permissions[j].Name })
+	return permissions
+}
+
+// Enforce evaluates casbinRequest, {subject, object, action}, against the permissions as a RBAC model would:
+// the subject, a user id "owner/name", may do the action on the object if a permission lists the subject,
+// or a role the subject has directly or through its sub-roles, the object among its Resources and the action
+// among its Actions, case-insensitively, with "*" matching anything. A permission whose Effect is "Deny" overrides
+// the ones allowing it. Only the permission permissionId "owner/name" is evaluated if it isn't empty,
+// while modelId and resourceId are ignored.
+func (f *Fake) Enforce(permissionId, modelId, resourceId string, casbinRequest casdoorsdk.CasbinRequest) (bool, error) {
+	if len(casbinRequest) < 3 {
+		return false, fmt.Errorf("casdoortest: the request %v isn't {subject, object, action}", casbinRequest)
+	}
+	subject := fmt.Sprint(casbinRequest[0])
+	object := fmt.Sprint(casbinRequest[1])
+	action := fmt.Sprint(casbinRequest[2])
+
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	allowed := false
+	for _, permission := range f.permissions {
+		if permissionId != "" && permissionId != fmt.Sprintf("%s/%s", permission.Owner, permission.Name) {
+			continue
+		}
+		if !f.grants(permission, subject, object, action) {
+			continue
+		}
+		if strings.EqualFold(permission.Effect, "Deny") {
+			return false, nil
+		}
+		allowed = true
+	}
+	return allowed, nil
+}
+
+func (f *Fake) EnforceWithContext(ctx context.Context, permissionId, modelId, resourceId string, casbinRequest casdoorsdk.CasbinRequest) (bool, error) {
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+	return f.Enforce(permissionId, modelId, resourceId, casbinRequest)
+}
+
+func (f *Fake) BatchEnforce(permissionId, modelId, resourceId string, casbinRequests []casdoorsdk.CasbinRequest) ([][]bool, error) {
+	results := make([]bool, len(casbinRequests))
+	for i, casbinRequest := range casbinRequests {
+		allowed, err := f.Enforce(permissionId, modelId, resourceId, casbinRequest)
+		if err != nil {
+			return nil, err
+		}
+		results[i] = allowed
+	}
+	return [][]bool{results}, nil
+}
+
+func (f *Fake) BatchEnforceWithContext(ctx context.Context, permissionId, modelId, resourceId string, casbinRequests []casdoorsdk.CasbinRequest) ([][]bool, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return f.BatchEnforce(permissionId, modelId, resourceId, casbinRequests)
+}
+
+// grants reports whether permission applies to subject doing action on object.
+func (f *Fake) grants(permission *casdoorsdk.Permission, subject string, object string, action string) bool {
+	if !matches(permission.Resources, object, false) || !matches(permission.Actions, action, true) {
+		return false
+	}
+	if matches(permission.Users, subject, false) {
+		return true
+	}
+	for _, roleId := range permission.Roles {
+		if roleId == "*" || f.hasRole(subject, roleId, map[string]bool{}) {
+			return true
+		}
+	}
+	return false
+}
+
+// hasRole reports whether subject is a user of the role roleId or of one of its sub-roles.
+func (f *Fake) hasRole(subject string, roleId string, visited map[string]bool) bool {
+	if visited[roleId] {
+		return false
+	}
+	visited[roleId] = true
+
+	role, ok := f.roles[roleId[strings.Index(roleId, "/")+1:]]
+	if !ok {
+		return false
+	}
+	if contains(role.Users, subject) {
+		return true
+	}
+	for _, subRoleId := range role.Roles {
+		if f.hasRole(subject, subRoleId, visited) {
+			return true
+		}
+	}
+	return false
+}
+
+// matches reports whether value is in values, or values has "*".
+func matches(values []string, value string, ignoreCase bool) bool {
+	for _, v := range values {
+		if v == "*" || v == value || (ignoreCase && strings.EqualFold(v, value)) {
+			return true
+		}
+	}
+	return false
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,481 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by internal/mockgen from the Client interface. DO NOT EDIT.
+
+package casdoortest
+
+import (
+	"context"
+
+	"github.com/casdoor/casdoor-go-sdk/casdoorsdk"
+)
+
+// Mock is a Client whose methods call the functions of its fields, e.g.
+//
+//	mock := &casdoortest.Mock{
+//		GetUserFunc: func(name string) (*casdoorsdk.User, error) {
+//			return &casdoorsdk.User{Name: name}, nil
+//		},
+//	}
+//
+// A method with a context whose function isn't set calls the function of the method without context,
+// so the function above also serves GetUserWithContext. Calling a method whose function isn't set panics.
+type Mock struct {
+	GetUsersFunc                        func() ([]*casdoorsdk.User, error)
+	GetUsersWithContextFunc             func(ctx context.Context) ([]*casdoorsdk.User, error)
+	GetUserFunc                         func(name string) (*casdoorsdk.User, error)
+	GetUserWithContextFunc              func(ctx context.Context, name string) (*casdoorsdk.User, error)
+	GetUserByEmailFunc                  func(email string) (*casdoorsdk.User, error)
+	GetUserByEmailWithContextFunc       func(ctx context.Context, email string) (*casdoorsdk.User, error)
+	GetUserByPhoneFunc                  func(phone string) (*casdoorsdk.User, error)
+	GetUserByPhoneWithContextFunc       func(ctx context.Context, phone string) (*casdoorsdk.User, error)
+	GetUserByUserIdFunc                 func(userId string) (*casdoorsdk.User, error)
+	GetUserByUserIdWithContextFunc      func(ctx context.Context, userId string) (*casdoorsdk.User, error)
+	AddUserFunc                         func(user *casdoorsdk.User) (bool, error)
+	AddUserWithContextFunc              func(ctx context.Context, user *casdoorsdk.User) (bool, error)
+	UpdateUserFunc                      func(user *casdoorsdk.User) (bool, error)
+	UpdateUserWithContextFunc           func(ctx context.Context, user *casdoorsdk.User) (bool, error)
+	DeleteUserFunc                      func(user *casdoorsdk.User) (bool, error)
+	DeleteUserWithContextFunc           func(ctx context.Context, user *casdoorsdk.User) (bool, error)
+	CheckUserPasswordFunc               func(user *casdoorsdk.User) (bool, error)
+	CheckUserPasswordWithContextFunc    func(ctx context.Context, user *casdoorsdk.User) (bool, error)
+	SetPasswordFunc                     func(owner, name, oldPassword, newPassword string) (bool, error)
+	SetPasswordWithContextFunc          func(ctx context.Context, owner, name, oldPassword, newPassword string) (bool, error)
+	GetRolesFunc                        func() ([]*casdoorsdk.Role, error)
+	GetRolesWithContextFunc             func(ctx context.Context) ([]*casdoorsdk.Role, error)
+	GetRoleFunc                         func(name string) (*casdoorsdk.Role, error)
+	GetRoleWithContextFunc              func(ctx context.Context, name string) (*casdoorsdk.Role, error)
+	AddRoleFunc                         func(role *casdoorsdk.Role) (bool, error)
+	AddRoleWithContextFunc              func(ctx context.Context, role *casdoorsdk.Role) (bool, error)
+	UpdateRoleFunc                      func(role *casdoorsdk.Role) (bool, error)
+	UpdateRoleWithContextFunc           func(ctx context.Context, role *casdoorsdk.Role) (bool, error)
+	DeleteRoleFunc                      func(role *casdoorsdk.Role) (bool, error)
+	DeleteRoleWithContextFunc           func(ctx context.Context, role *casdoorsdk.Role) (bool, error)
+	GetPermissionsFunc                  func() ([]*casdoorsdk.Permission, error)
+	GetPermissionsWithContextFunc       func(ctx context.Context) ([]*casdoorsdk.Permission, error)
+	GetPermissionsByRoleFunc            func(name string) ([]*casdoorsdk.Permission, error)
+	GetPermissionsByRoleWithContextFunc func(ctx context.Context, name string) ([]*casdoorsdk.Permission, error)
+	GetPermissionFunc                   func(name string) (*casdoorsdk.Permission, error)
+	GetPermissionWithContextFunc        func(ctx context.Context, name string) (*casdoorsdk.Permission, error)
+	AddPermissionFunc                   func(permission *casdoorsdk.Permission) (bool, error)
+	AddPermissionWithContextFunc        func(ctx context.Context, permission *casdoorsdk.Permission) (bool, error)
+	UpdatePermissionFunc                func(permission *casdoorsdk.Permission) (bool, error)
+	UpdatePermissionWithContextFunc     func(ctx context.Context, permission *casdoorsdk.Permission) (bool, error)
+	DeletePermissionFunc                func(permission *casdoorsdk.Permission) (bool, error)
+	DeletePermissionWithContextFunc     func(ctx context.Context, permission *casdoorsdk.Permission) (bool, error)
+	EnforceFunc                         func(permissionId, modelId, resourceId string, casbinRequest casdoorsdk.CasbinRequest) (bool, error)
+	EnforceWithContextFunc              func(ctx context.Context, permissionId, modelId, resourceId string, casbinRequest casdoorsdk.CasbinRequest) (bool, error)
+	BatchEnforceFunc                    func(permissionId, modelId, resourceId string, casbinRequests []casdoorsdk.CasbinRequest) ([][]bool, error)
+	BatchEnforceWithContextFunc         func(ctx context.Context, permissionId, modelId, resourceId string, casbinRequests []casdoorsdk.CasbinRequest) ([][]bool, error)
+	ParseJwtTokenFunc                   func(token string) (*casdoorsdk.Claims, error)
+}
+
+func (m *Mock) GetUsers() ([]*casdoorsdk.User, error) {
+	if m.GetUsersFunc == nil {
+		panic("casdoortest: Mock.GetUsersFunc isn't set")
+	}
+	return m.GetUsersFunc()
+}
+
+func (m *Mock) GetUsersWithContext(ctx context.Context) ([]*casdoorsdk.User, error) {
+	if m.GetUsersWithContextFunc != nil {
+		return m.GetUsersWithContextFunc(ctx)
+	}
+	if m.GetUsersFunc != nil {
+		return m.GetUsersFunc()
+	}
+	panic("casdoortest: neither Mock.GetUsersWithContextFunc nor Mock.GetUsersFunc is set")
+}
+
+func (m *Mock) GetUser(name string) (*casdoorsdk.User, error) {
+	if m.GetUserFunc == nil {
+		panic("casdoortest: Mock.GetUserFunc isn't set")
+	}
+	return m.GetUserFunc(name)
+}
+
+func (m *Mock) GetUserWithContext(ctx context.Context, name string) (*casdoorsdk.User, error) {
+	if m.GetUserWithContextFunc != nil {
+		return m.GetUserWithContextFunc(ctx, name)
+	}
+	if m.GetUserFunc != nil {
+		return m.GetUserFunc(name)
+	}
+	panic("casdoortest: neither Mock.GetUserWithContextFunc nor Mock.GetUserFunc is set")
+}
+
+func (m *Mock) GetUserByEmail(email string) (*casdoorsdk.User, error) {
+	if m.GetUserByEmailFunc == nil {
+		panic("casdoortest: Mock.GetUserByEmailFunc isn't set")
+	}
+	return m.GetUserByEmailFunc(email)
+}
+
+func (m *Mock) GetUserByEmailWithContext(ctx context.Context, email string) (*casdoorsdk.User, error) {
+	if m.GetUserByEmailWithContextFunc != nil {
+		return m.GetUserByEmailWithContextFunc(ctx, email)
+	}
+	if m.GetUserByEmailFunc != nil {
+		return m.GetUserByEmailFunc(email)
+	}
+	panic("casdoortest: neither Mock.GetUserByEmailWithContextFunc nor Mock.GetUserByEmailFunc is set")
+}
+
+func (m *Mock) GetUserByPhone(phone string) (*casdoorsdk.User, error) {
+	if m.GetUserByPhoneFunc == nil {
+		panic("casdoortest: Mock.GetUserByPhoneFunc isn't set")
+	}
+	return m.GetUserByPhoneFunc(phone)
+}
+
+func (m *Mock) GetUserByPhoneWithContext(ctx context.Context, phone string) (*casdoorsdk.User, error) {
+	if m.GetUserByPhoneWithContextFunc != nil {
+		return m.GetUserByPhoneWithContextFunc(ctx, phone)
+	}
+	if m.GetUserByPhoneFunc != nil {
+		return m.GetUserByPhoneFunc(phone)
+	}
+	panic("casdoortest: neither Mock.GetUserByPhoneWithContextFunc nor Mock.GetUserByPhoneFunc is set")
+}
+
+func (m *Mock) GetUserByUserId(userId string) (*casdoorsdk.User, error) {
+	if m.GetUserByUserIdFunc == nil {
+		panic("casdoortest: Mock.GetUserByUserIdFunc isn't set")
+	}
+	return m.GetUserByUserIdFunc(userId)
+}
+
+func (m *Mock) GetUserByUserIdWithContext(ctx context.Context, userId string) (*casdoorsdk.User, error) {
+	if m.GetUserByUserIdWithContextFunc != nil {
+		return m.GetUserByUserIdWithContextFunc(ctx, userId)
+	}
+	if m.GetUserByUserIdFunc != nil {
+		return m.GetUserByUserIdFunc(userId)
+	}
+	panic("casdoortest: neither Mock.GetUserByUserIdWithContextFunc nor Mock.GetUserByUserIdFunc is set")
+}
+
+func (m *Mock) AddUser(user *casdoorsdk.User) (bool, error) {
+	if m.AddUserFunc == nil {
+		panic("casdoortest: Mock.AddUserFunc isn't set")
+	}
+	return m.AddUserFunc(user)
+}
+
+func (m *Mock) AddUserWithContext(ctx context.Context, user *casdoorsdk.User) (bool, error) {
+	if m.AddUserWithContextFunc != nil {
+		return m.AddUserWithContextFunc(ctx, user)
+	}
+	if m.AddUserFunc != nil {
+		return m.AddUserFunc(user)
+	}
+	panic("casdoortest: neither Mock.AddUserWithContextFunc nor Mock.AddUserFunc is set")
+}
+
+func (m *Mock) UpdateUser(user *casdoorsdk.User) (bool, error) {
+	if m.UpdateUserFunc == nil {
+		panic("casdoortest: Mock.UpdateUserFunc isn't set")
+	}
+	return m.UpdateUserFunc(user)
+}
+
+func (m *Mock) UpdateUserWithContext(ctx context.Context, user *casdoorsdk.User) (bool, error) {
+	if m.UpdateUserWithContextFunc != nil {
+		return m.UpdateUserWithContextFunc(ctx, user)
+	}
+	if m.UpdateUserFunc != nil {
+		return m.UpdateUserFunc(user)
+	}
+	panic("casdoortest: neither Mock.UpdateUserWithContextFunc nor Mock.UpdateUserFunc is set")
+}
+
+func (m *Mock) DeleteUser(user *casdoorsdk.User) (bool, error) {
+	if m.DeleteUserFunc == nil {
+		panic("casdoortest: Mock.DeleteUserFunc isn't set")
+	}
+	return m.DeleteUserFunc(user)
+}
+
+func (m *Mock) DeleteUserWithContext(ctx context.Context, user *casdoorsdk.User) (bool, error) {
+	if m.DeleteUserWithContextFunc != nil {
+		return m.DeleteUserWithContextFunc(ctx, user)
+	}
+	if m.DeleteUserFunc != nil {
+		return m.DeleteUserFunc(user)
+	}
+	panic("casdoortest: neither Mock.DeleteUserWithContextFunc nor Mock.DeleteUserFunc is set")
+}
+
+func (m *Mock) CheckUserPassword(user *casdoorsdk.User) (bool, error) {
+	if m.CheckUserPasswordFunc == nil {
+		panic("casdoortest: Mock.CheckUserPasswordFunc isn't set")
+	}
+	return m.CheckUserPasswordFunc(user)
+}
+
+func (m *Mock) CheckUserPasswordWithContext(ctx context.Context, user *casdoorsdk.User) (bool, error) {
+	if m.CheckUserPasswordWithContextFunc != nil {
+		return m.CheckUserPasswordWithContextFunc(ctx, user)
+	}
+	if m.CheckUserPasswordFunc != nil {
+		return m.CheckUserPasswordFunc(user)
+	}
+	panic("casdoortest: neither Mock.CheckUserPasswordWithContextFunc nor Mock.CheckUserPasswordFunc is set")
+}
+
+func (m *Mock) SetPassword(owner, name, oldPassword, newPassword string) (bool, error) {
+	if m.SetPasswordFunc == nil {
+		panic("casdoortest: Mock.SetPasswordFunc isn't set")
+	}
+	return m.SetPasswordFunc(owner, name, oldPassword, newPassword)
+}
+
+func (m *Mock) SetPasswordWithContext(ctx context.Context, owner, name, oldPassword, newPassword string) (bool, error) {
+	if m.SetPasswordWithContextFunc != nil {
+		return m.SetPasswordWithContextFunc(ctx, owner, name, oldPassword, newPassword)
+	}
+	if m.SetPasswordFunc != nil {
+		return m.SetPasswordFunc(owner, name, oldPassword, newPassword)
+	}
+	panic("casdoortest: neither Mock.SetPasswordWithContextFunc nor Mock.SetPasswordFunc is set")
+}
+
+func (m *Mock) GetRoles() ([]*casdoorsdk.Role, error) {
+	if m.GetRolesFunc == nil {
+		panic("casdoortest: Mock.GetRolesFunc isn't set")
+	}
+	return m.GetRolesFunc()
+}
+
+func (m *Mock) GetRolesWithContext(ctx context.Context) ([]*casdoorsdk.Role, error) {
+	if m.GetRolesWithContextFunc != nil {
+		return m.GetRolesWithContextFunc(ctx)
+	}
+	if m.GetRolesFunc != nil {
+		return m.GetRolesFunc()
+	}
+	panic("casdoortest: neither Mock.GetRolesWithContextFunc nor Mock.GetRolesFunc is set")
+}
+
+func (m *Mock) GetRole(name string) (*casdoorsdk.Role, error) {
+	if m.GetRoleFunc == nil {
+		panic("casdoortest: Mock.GetRoleFunc isn't set")
+	}
+	return m.GetRoleFunc(name)
+}
+
+func (m *Mock) GetRoleWithContext(ctx context.Context, name string) (*casdoorsdk.Role, error) {
+	if m.GetRoleWithContextFunc != nil {
+		return m.GetRoleWithContextFunc(ctx, name)
+	}
+	if m.GetRoleFunc != nil {
+		return m.GetRoleFunc(name)
+	}
+	panic("casdoortest: neither Mock.GetRoleWithContextFunc nor Mock.GetRoleFunc is set")
+}
+
+func (m *Mock) AddRole(role *casdoorsdk.Role) (bool, error) {
+	if m.AddRoleFunc == nil {
+		panic("casdoortest: Mock.AddRoleFunc isn't set")
+	}
+	return m.AddRoleFunc(role)
+}
+
+func (m *Mock) AddRoleWithContext(ctx context.Context, role *casdoorsdk.Role) (bool, error) {
+	if m.AddRoleWithContextFunc != nil {
+		return m.AddRoleWithContextFunc(ctx, role)
+	}
+	if m.AddRoleFunc != nil {
+		return m.AddRoleFunc(role)
+	}
+	panic("casdoortest: neither Mock.AddRoleWithContextFunc nor Mock.AddRoleFunc is set")
+}
+
+func (m *Mock) UpdateRole(role *casdoorsdk.Role) (bool, error) {
+	if m.UpdateRoleFunc == nil {
+		panic("casdoortest: Mock.UpdateRoleFunc isn't set")
+	}
+	return m.UpdateRoleFunc(role)
+}
+
+func (m *Mock) UpdateRoleWithContext(ctx context.Context, role *casdoorsdk.Role) (bool, error) {
+	if m.UpdateRoleWithContextFunc != nil {
+		return m.UpdateRoleWithContextFunc(ctx, role)
+	}
+	if m.UpdateRoleFunc != nil {
+		return m.UpdateRoleFunc(role)
+	}
+	panic("casdoortest: neither Mock.UpdateRoleWithContextFunc nor Mock.UpdateRoleFunc is set")
+}
+
+func (m *Mock) DeleteRole(role *casdoorsdk.Role) (bool, error) {
+	if m.DeleteRoleFunc == nil {
+		panic("casdoortest: Mock.DeleteRoleFunc isn't set")
+	}
+	return m.DeleteRoleFunc(role)
+}
+
+func (m *Mock) DeleteRoleWithContext(ctx context.Context, role *casdoorsdk.Role) (bool, error) {
+	if m.DeleteRoleWithContextFunc != nil {
+		return m.DeleteRoleWithContextFunc(ctx, role)
+	}
+	if m.DeleteRoleFunc != nil {
+		return m.DeleteRoleFunc(role)
+	}
+	panic("casdoortest: neither Mock.DeleteRoleWithContextFunc nor Mock.DeleteRoleFunc is set")
+}
+
+func (m *Mock) GetPermissions() ([]*casdoorsdk.Permission, error) {
+	if m.GetPermissionsFunc == nil {
+		panic("casdoortest: Mock.GetPermissionsFunc isn't set")
+	}
+	return m.GetPermissionsFunc()
+}
+
+func (m *Mock) GetPermissionsWithContext(ctx context.Context) ([]*casdoorsdk.Permission, error) {
+	if m.GetPermissionsWithContextFunc != nil {
+		return m.GetPermissionsWithContextFunc(ctx)
+	}
+	if m.GetPermissionsFunc != nil {
+		return m.GetPermissionsFunc()
+	}
+	panic("casdoortest: neither Mock.GetPermissionsWithContextFunc nor Mock.GetPermissionsFunc is set")
+}
+
+func (m *Mock) GetPermissionsByRole(name string) ([]*casdoorsdk.Permission, error) {
+	if m.GetPermissionsByRoleFunc == nil {
+		panic("casdoortest: Mock.GetPermissionsByRoleFunc isn't set")
+	}
+	return m.GetPermissionsByRoleFunc(name)
+}
+
+func (m *Mock) GetPermissionsByRoleWithContext(ctx context.Context, name string) ([]*casdoorsdk.Permission, error) {
+	if m.GetPermissionsByRoleWithContextFunc != nil {
+		return m.GetPermissionsByRoleWithContextFunc(ctx, name)
+	}
+	if m.GetPermissionsByRoleFunc != nil {
+		return m.GetPermissionsByRoleFunc(name)
+	}
+	panic("casdoortest: neither Mock.GetPermissionsByRoleWithContextFunc nor Mock.GetPermissionsByRoleFunc is set")
+}
+
+func (m *Mock) GetPermission(name string) (*casdoorsdk.Permission, error) {
+	if m.GetPermissionFunc == nil {
+		panic("casdoortest: Mock.GetPermissionFunc isn't set")
+	}
+	return m.GetPermissionFunc(name)
+}
+
+func (m *Mock) GetPermissionWithContext(ctx context.Context, name string) (*casdoorsdk.Permission, error) {
+	if m.GetPermissionWithContextFunc != nil {
+		return m.GetPermissionWithContextFunc(ctx, name)
+	}
+	if m.GetPermissionFunc != nil {
+		return m.GetPermissionFunc(name)
+	}
+	panic("casdoortest: neither Mock.GetPermissionWithContextFunc nor Mock.GetPermissionFunc is set")
+}
+
+func (m *Mock) AddPermission(permission *casdoorsdk.Permission) (bool, error) {
+	if m.AddPermissionFunc == nil {
+		panic("casdoortest: Mock.AddPermissionFunc isn't set")
+	}
+	return m.AddPermissionFunc(permission)
+}
+
+func (m *Mock) AddPermissionWithContext(ctx context.Context, permission *casdoorsdk.Permission) (bool, error) {
+	if m.AddPermissionWithContextFunc != nil {
+		return m.AddPermissionWithContextFunc(ctx, permission)
+	}
+	if m.AddPermissionFunc != nil {
+		return m.AddPermissionFunc(permission)
+	}
+	panic("casdoortest: neither Mock.AddPermissionWithContextFunc nor Mock.AddPermissionFunc is set")
+}
+
+func (m *Mock) UpdatePermission(permission *casdoorsdk.Permission) (bool, error) {
+	if m.UpdatePermissionFunc == nil {
+		panic("casdoortest: Mock.UpdatePermissionFunc isn't set")
+	}
+	return m.UpdatePermissionFunc(permission)
+}
+
+func (m *Mock) UpdatePermissionWithContext(ctx context.Context, permission *casdoorsdk.Permission) (bool, error) {
+	if m.UpdatePermissionWithContextFunc != nil {
+		return m.UpdatePermissionWithContextFunc(ctx, permission)
+	}
+	if m.UpdatePermissionFunc != nil {
+		return m.UpdatePermissionFunc(permission)
+	}
+	panic("casdoortest: neither Mock.UpdatePermissionWithContextFunc nor Mock.UpdatePermissionFunc is set")
+}
+
+func (m *Mock) DeletePermission(permission *casdoorsdk.Permission) (bool, error) {
+	if m.DeletePermissionFunc == nil {
+		panic("casdoortest: Mock.DeletePermissionFunc isn't set")
+	}
+	return m.DeletePermissionFunc(permission)
+}
+
+func (m *Mock) DeletePermissionWithContext(ctx context.Context, permission *casdoorsdk.Permission) (bool, error) {
+	if m.DeletePermissionWithContextFunc != nil {
+		return m.DeletePermissionWithContextFunc(ctx, permission)
+	}
+	if m.DeletePermissionFunc != nil {
+		return m.DeletePermissionFunc(permission)
+	}
+	panic("casdoortest: neither Mock.DeletePermissionWithContextFunc nor Mock.DeletePermissionFunc is set")
+}
+
+func (m *Mock) Enforce(permissionId, modelId, resourceId string, casbinRequest casdoorsdk.CasbinRequest) (bool, error) {
+	if m.EnforceFunc == nil {
+		panic("casdoortest: Mock.EnforceFunc isn't set")
+	}
+	return m.EnforceFunc(permissionId, modelId, resourceId, casbinRequest)
+}
+
+func (m *Mock) EnforceWithContext(ctx context.Context, permissionId, modelId, resourceId string, casbinRequest casdoorsdk.CasbinRequest) (bool, error) {
+	if m.EnforceWithContextFunc != nil {
+		return m.EnforceWithContextFunc(ctx, permissionId, modelId, resourceId, casbinRequest)
+	}
+	if m.EnforceFunc != nil {
+		return m.EnforceFunc(permissionId, modelId, resourceId, casbinRequest)
+	}
+	panic("casdoortest: neither Mock.EnforceWithContextFunc nor Mock.EnforceFunc is set")
+}
+
+func (m *Mock) BatchEnforce(permissionId, modelId, resourceId string, casbinRequests []casdoorsdk.CasbinRequest) ([][]bool, error) {
+	if m.BatchEnforceFunc == nil {
+		panic("casdoortest: Mock.BatchEnforceFunc isn't set")
+	}
+	return m.BatchEnforceFunc(permissionId, modelId, resourceId, casbinRequests)
+}
+
+func (m *Mock) BatchEnforceWithContext(ctx context.Context, permissionId, modelId, resourceId string, casbinRequests []casdoorsdk.CasbinRequest) ([][]bool, error) {
+	if m.BatchEnforceWithContextFunc != nil {
+		return m.BatchEnforceWithContextFunc(ctx, permissionId, modelId, resourceId, casbinRequests)
+	}
+	if m.BatchEnforceFunc != nil {
+		return m.BatchEnforceFunc(permissionId, modelId, resourceId, casbinRequests)
+	}
+	panic("casdoortest: neither Mock.BatchEnforceWithContextFunc nor Mock.BatchEnforceFunc is set")
+}
+
+func (m *Mock) ParseJwtToken(token string) (*casdoorsdk.Claims, error) {
+	if m.ParseJwtTokenFunc == nil {
+		panic("casdoortest: Mock.ParseJwtTokenFunc isn't set")
+	}
+	return m.ParseJwtTokenFunc(token)
+}
@@ -0,0 +1,397 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoortest
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/casdoor/casdoor-go-sdk/casdoorsdk"
+	"github.com/golang-jwt/jwt/v4"
+)
+
+const serverKid = "casdoortest"
+
+// ServerOptions configures a Server. The zero value is a server of the organization "built-in" without users.
+type ServerOptions struct {
+	// Organization and Application are "built-in" and "app-built-in" by default.
+	Organization string
+	Application  string
+	// ClientId and ClientSecret are the credentials of the application, "client-id" and "client-secret" by default.
+	ClientId     string
+	ClientSecret string
+	// Users are the users of the organization, whose Password is checked by the password grant.
+	Users []*casdoorsdk.User
+	// TokenTtl is how long the issued tokens are valid, an hour by default.
+	TokenTtl time.Duration
+}
+
+// Server is a local Casdoor server for the integration tests, emulating the endpoints the applications rely on
+// the most with the users it is given:
+//
+//   - /api/get-user and /api/get-users, authenticated with the client id and secret, or an issued access token,
+//   - /api/login/oauth/access_token, with the authorization_code, password, client_credentials
+//     and refresh_token grants, and /api/login/oauth/refresh_token,
+//   - /.well-known/jwks and /.well-known/openid-configuration.
+//
+// The tokens are JWTs signed with a key generated for the server, verified by ParseJwtToken, e.g.
+//
+//	server := casdoortest.NewServer(&casdoortest.ServerOptions{Users: users})
+//	defer server.Close()
+//
+//	client := server.NewClient()
+//	token, err := client.GetOAuthToken(server.IssueCode("alice"), "state")
+type Server struct {
+	*httptest.Server
+	options ServerOptions
+
+	// Certificate is the PEM certificate of the key the tokens are signed with.
+	Certificate string
+	key         *rsa.PrivateKey
+
+	mu            sync.Mutex
+	users         map[string]*casdoorsdk.User
+	codes         map[string]string
+	accessTokens  map[string]bool
+	refreshTokens map[string]string
+	nextId        int
+}
+
+// NewServer starts a Server, which must be closed with Close. options can be nil.
+func NewServer(options *ServerOptions) *Server {
+	if options == nil {
+		options = &ServerOptions{}
+	}
+	s := &Server{
+		options:       *options,
+		users:         map[string]*casdoorsdk.User{},
+		codes:         map[string]string{},
+		accessTokens:  map[string]bool{},
+		refreshTokens: map[string]string{},
+	}
+	if s.options.Organization == "" {
+		s.options.Organization = "built-in"
+	}
+	if s.options.Application == "" {
+		s.options.Application = "app-built-in"
+	}
+	if s.options.ClientId == "" {
+		s.options.ClientId = "client-id"
+	}
+	if s.options.ClientSecret == "" {
+		s.options.ClientSecret = "client-secret"
+	}
+	if s.options.TokenTtl <= 0 {
+		s.options.TokenTtl = time.Hour
+	}
+
+	s.generateKey()
+	for _, user := range options.Users {
+		s.AddUser(user)
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/get-user", s.handleGetUser)
+	mux.HandleFunc("/api/get-users", s.handleGetUsers)
+	mux.HandleFunc("/api/login/oauth/access_token", s.handleToken)
+	mux.HandleFunc("/api/login/oauth/refresh_token", s.handleToken)
+	mux.HandleFunc("/.well-known/jwks", s.handleJwks)
+	mux.HandleFunc("/.well-known/openid-configuration", s.handleOpenIDConfiguration)
+	s.Server = httptest.NewServer(mux)
+	return s
+}
+
+// AuthConfig returns the configuration of a client of the server.
+func (s *Server) AuthConfig() *casdoorsdk.AuthConfig {
+	return &casdoorsdk.AuthConfig{
+		Endpoint:         s.URL,
+		ClientId:         s.options.ClientId,
+		ClientSecret:     s.options.ClientSecret,
+		Certificate:      s.Certificate,
+		OrganizationName: s.options.Organization,
+		ApplicationName:  s.options.Application,
+	}
+}
+
+// NewClient returns a client of the server.
+func (s *Server) NewClient() *casdoorsdk.Client {
+	return casdoorsdk.NewClient(s.AuthConfig())
+}
+
+// AddUser adds or replaces a user of the organization.
+func (s *Server) AddUser(user *casdoorsdk.User) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	u := *user
+	if u.Owner == "" {
+		u.Owner = s.options.Organization
+	}
+	if u.Id == "" {
+		u.Id = fmt.Sprintf("%s-%s", u.Owner, u.Name)
+	}
+	s.users[u.Name] = &u
+}
+
+// IssueCode returns an authorization code of the user named name, as if they had signed in,
+// to be exchanged once with GetOAuthToken.
+func (s *Server) IssueCode(name string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	code := s.newId("code")
+	s.codes[code] = name
+	return code
+}
+
+// newId returns a unique identifier with prefix. s.mu must be held.
+func (s *Server) newId(prefix string) string {
+	s.nextId++
+	return fmt.Sprintf("casdoortest-%s-%d", prefix, s.nextId)
+}
+
+func (s *Server) generateKey() {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		panic(err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "casdoortest"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(24 * time.Hour),
+	}
+	certificate, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		panic(err)
+	}
+
+	s.key = key
+	s.Certificate = string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certificate}))
+}
+
+// isAuthorized reports whether r is authenticated with the client id and secret, or an issued access token.
+func (s *Server) isAuthorized(r *http.Request) bool {
+	clientId, clientSecret, ok := r.BasicAuth()
+	if !ok {
+		clientId, clientSecret = r.URL.Query().Get("clientId"), r.URL.Query().Get("clientSecret")
+	}
+	if clientId == s.options.ClientId && clientSecret == s.options.ClientSecret {
+		return true
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	return s.accessTokens[token]
+}
+
+func (s *Server) handleGetUser(w http.ResponseWriter, r *http.Request) {
+	if !s.isAuthorized(r) {
+		writeError(w, "Unauthorized operation")
+		return
+	}
+
+	query := r.URL.Query()
+	var match func(user *casdoorsdk.User) bool
+	switch {
+	case query.Get("id") != "":
+		id := query.Get("id")
+		match = func(user *casdoorsdk.User) bool { return user.GetId() == id }
+	case query.Get("email") != "":
+		match = func(user *casdoorsdk.User) bool { return user.Email == query.Get("email") }
+	case query.Get("phone") != "":
+		match = func(user *casdoorsdk.User) bool { return user.Phone == query.Get("phone") }
+	case query.Get("userId") != "":
+		match = func(user *casdoorsdk.User) bool { return user.Id == query.Get("userId") }
+	default:
+		writeError(w, "Missing parameter")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, user := range s.users {
+		if match(user) {
+			writeJson(w, http.StatusOK, user)
+			return
+		}
+	}
+	writeJson(w, http.StatusOK, nil)
+}
+
+func (s *Server) handleGetUsers(w http.ResponseWriter, r *http.Request) {
+	if !s.isAuthorized(r) {
+		writeError(w, "Unauthorized operation")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	users := []*casdoorsdk.User{}
+	for _, user := range s.users {
+		if user.Owner == r.URL.Query().Get("owner") {
+			users = append(users, user)
+		}
+	}
+	writeJson(w, http.StatusOK, users)
+}
+
+func (s *Server) handleToken(w http.ResponseWriter, r *http.Request) {
+	err := r.ParseForm()
+	if err != nil {
+		writeOAuthError(w, "invalid_request", err.Error())
+		return
+	}
+	if r.PostForm.Get("client_id") != s.options.ClientId || r.PostForm.Get("client_secret") != s.options.ClientSecret {
+		writeOAuthError(w, "invalid_client", "invalid client_id or client_secret")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var user *casdoorsdk.User
+	switch grantType := r.PostForm.Get("grant_type"); grantType {
+	case "authorization_code":
+		code := r.PostForm.Get("code")
+		name, ok := s.codes[code]
+		if !ok {
+			writeOAuthError(w, "invalid_grant", "authorization code is invalid")
+			return
+		}
+		delete(s.codes, code)
+		user = s.users[name]
+	case "password":
+		u, ok := s.users[r.PostForm.Get("username")]
+		if !ok || u.Password != r.PostForm.Get("password") {
+			writeOAuthError(w, "invalid_grant", "password or code is incorrect")
+			return
+		}
+		user = u
+	case "refresh_token":
+		name, ok := s.refreshTokens[r.PostForm.Get("refresh_token")]
+		if !ok {
+			writeOAuthError(w, "invalid_grant", "refresh token is invalid")
+			return
+		}
+		user = s.users[name]
+	case "client_credentials":
+		user = &casdoorsdk.User{Owner: "admin", Name: s.options.Application, Id: s.options.ClientId}
+	default:
+		writeOAuthError(w, "unsupported_grant_type", fmt.Sprintf("grant_type: %s is not supported", grantType))
+		return
+	}
+	if user == nil {
+		writeOAuthError(w, "invalid_grant", "the user doesn't exist")
+		return
+	}
+
+	accessToken, err := s.signToken(user)
+	if err != nil {
+		writeOAuthError(w, "server_error", err.Error())
+		return
+	}
+	refreshToken := s.newId("refresh-token")
+	s.accessTokens[accessToken] = true
+	s.refreshTokens[refreshToken] = user.Name
+
+	writeJson(w, http.StatusOK, map[string]interface{}{
+		"access_token":  accessToken,
+		"id_token":      accessToken,
+		"refresh_token": refreshToken,
+		"token_type":    "Bearer",
+		"expires_in":    int(s.options.TokenTtl.Seconds()),
+		"scope":         r.PostForm.Get("scope"),
+	})
+}
+
+// signToken returns a JWT of the claims of user. s.mu must be held.
+func (s *Server) signToken(user *casdoorsdk.User) (string, error) {
+	now := time.Now()
+	claims := casdoorsdk.Claims{
+		User: *user,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    s.URL,
+			Subject:   user.Id,
+			Audience:  []string{s.options.ClientId},
+			ExpiresAt: jwt.NewNumericDate(now.Add(s.options.TokenTtl)),
+			NotBefore: jwt.NewNumericDate(now),
+			IssuedAt:  jwt.NewNumericDate(now),
+			ID:        s.newId("jti"),
+		},
+	}
+	claims.User.Password = ""
+
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = serverKid
+	return token.SignedString(s.key)
+}
+
+func (s *Server) handleJwks(w http.ResponseWriter, r *http.Request) {
+	block, _ := pem.Decode([]byte(s.Certificate))
+	writeJson(w, http.StatusOK, casdoorsdk.Jwks{Keys: []casdoorsdk.Jwk{{
+		Kty: "RSA",
+		Kid: serverKid,
+		Use: "sig",
+		Alg: "RS256",
+		N:   base64.RawURLEncoding.EncodeToString(s.key.N.Bytes()),
+		E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(s.key.E)).Bytes()),
+		X5c: []string{base64.StdEncoding.EncodeToString(block.Bytes)},
+	}}})
+}
+
+func (s *Server) handleOpenIDConfiguration(w http.ResponseWriter, r *http.Request) {
+	writeJson(w, http.StatusOK, map[string]interface{}{
+		"issuer":                                s.URL,
+		"authorization_endpoint":                s.URL + "/login/oauth/authorize",
+		"token_endpoint":                        s.URL + "/api/login/oauth/access_token",
+		"jwks_uri":                              s.URL + "/.well-known/jwks",
+		"response_types_supported":              []string{"code"},
+		"grant_types_supported":                 []string{"authorization_code", "password", "client_credentials", "refresh_token"},
+		"id_token_signing_alg_values_supported": []string{"RS256"},
+	})
+}
+
+func writeJson(w http.ResponseWriter, statusCode int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(statusCode)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// writeError writes an error Response, which the server answers with a 200 status.
+func writeError(w http.ResponseWriter, msg string) {
+	writeJson(w, http.StatusOK, map[string]interface{}{"status": "error", "msg": msg})
+}
+
+func writeOAuthError(w http.ResponseWriter, error string, description string) {
+	writeJson(w, http.StatusBadRequest, map[string]string{"error": error, "error_description": description})
+}
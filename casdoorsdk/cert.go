@@ -0,0 +1,189 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// defaultCertName is the certificate the server signs the tokens of an application without a cert with.
+const defaultCertName = "cert-built-in"
+
+// Cert has the same definition as https://github.com/casdoor/casdoor/blob/master/object/cert.go#L25
+type Cert struct {
+	Owner       string `xorm:"varchar(100) notnull pk" json:"owner"`
+	Name        string `xorm:"varchar(100) notnull pk" json:"name"`
+	CreatedTime string `xorm:"varchar(100)" json:"createdTime"`
+
+	DisplayName     string `xorm:"varchar(100)" json:"displayName"`
+	Scope           string `xorm:"varchar(100)" json:"scope"`
+	Type            string `xorm:"varchar(100)" json:"type"`
+	CryptoAlgorithm string `xorm:"varchar(100)" json:"cryptoAlgorithm"`
+	BitSize         int    `json:"bitSize"`
+	ExpireInYears   int    `json:"expireInYears"`
+
+	Certificate            string `xorm:"mediumtext" json:"certificate"`
+	PrivateKey             string `xorm:"mediumtext" json:"privateKey"`
+	AuthorityPublicKey     string `xorm:"mediumtext" json:"authorityPublicKey"`
+	AuthorityRootPublicKey string `xorm:"mediumtext" json:"authorityRootPublicKey"`
+}
+
+func (c *Client) GetCerts() ([]*Cert, error) {
+	return c.GetCertsWithContext(context.Background())
+}
+
+func (c *Client) GetCertsWithContext(ctx context.Context) ([]*Cert, error) {
+	queryMap := map[string]string{
+		"owner": "admin",
+	}
+
+	url := c.GetUrl("get-certs", queryMap)
+
+	bytes, err := c.DoGetBytesWithContext(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	var certs []*Cert
+	err = c.unmarshal(bytes, &certs)
+	if err != nil {
+		return nil, err
+	}
+	return certs, nil
+}
+
+func (c *Client) GetPaginationCerts(p int, pageSize int, queryMap map[string]string) ([]*Cert, int, error) {
+	return c.GetPaginationCertsWithContext(context.Background(), p, pageSize, queryMap)
+}
+
+func (c *Client) GetPaginationCertsWithContext(ctx context.Context, p int, pageSize int, queryMap map[string]string) ([]*Cert, int, error) {
+	queryMap = getPaginationQueryMap("admin", p, pageSize, queryMap)
+
+	url := c.GetUrl("get-certs", queryMap)
+
+	response, err := c.DoGetResponseWithContext(ctx, url)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	bytes, err := json.Marshal(response.Data)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var certs []*Cert
+	err = c.unmarshal(bytes, &certs)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	count, err := getCount(response)
+	if err != nil {
+		return nil, 0, err
+	}
+	return certs, count, nil
+}
+
+func (c *Client) GetCert(name string) (*Cert, error) {
+	return c.GetCertWithContext(context.Background(), name)
+}
+
+func (c *Client) GetCertWithContext(ctx context.Context, name string) (*Cert, error) {
+	queryMap := map[string]string{
+		"id": fmt.Sprintf("admin/%s", name),
+	}
+
+	url := c.GetUrl("get-cert", queryMap)
+
+	bytes, err := c.DoGetBytesWithContext(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	var cert *Cert
+	err = c.unmarshal(bytes, &cert)
+	if err != nil {
+		return nil, err
+	}
+	if cert == nil {
+		return nil, c.newAPIError(fmt.Sprintf("the cert: %s doesn't exist", name))
+	}
+	return cert, nil
+}
+
+// GetDefaultCert returns the cert the tokens of the configured application are signed with.
+// Its Certificate can be used as the Certificate of the AuthConfig.
+func (c *Client) GetDefaultCert() (*Cert, error) {
+	return c.GetDefaultCertWithContext(context.Background())
+}
+
+func (c *Client) GetDefaultCertWithContext(ctx context.Context) (*Cert, error) {
+	application, err := c.getCachedApplication(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	name := application.Cert
+	if name == "" {
+		name = defaultCertName
+	}
+	return c.GetCertWithContext(ctx, name)
+}
+
+// applicationCertTtl is how long the cert of the configured application is cached by peekApplicationCert.
+const applicationCertTtl = time.Hour
+
+// peekApplicationCert returns the cert the tokens of the configured application are signed with, if it is cached.
+// It never waits for the server: the cert is fetched in the background by the first call, and then refreshed
+// in the background every applicationCertTtl.
+func (c *Client) peekApplicationCert() (*Cert, bool) {
+	authConfig := c.getAuthConfig()
+	key := authConfig.Endpoint + "/" + authConfig.ApplicationName
+	return c.applicationCert.peek(key, applicationCertTtl, c.GetDefaultCertWithContext, true)
+}
+
+func (c *Client) UpdateCert(cert *Cert) (bool, error) {
+	return c.UpdateCertWithContext(context.Background(), cert)
+}
+
+func (c *Client) UpdateCertWithContext(ctx context.Context, cert *Cert) (bool, error) {
+	_, affected, err := c.modifyCert(ctx, "update-cert", cert)
+	return affected, err
+}
+
+func (c *Client) AddCert(cert *Cert) (bool, error) {
+	return c.AddCertWithContext(context.Background(), cert)
+}
+
+func (c *Client) AddCertWithContext(ctx context.Context, cert *Cert) (bool, error) {
+	_, affected, err := c.modifyCert(ctx, "add-cert", cert)
+	return affected, err
+}
+
+func (c *Client) DeleteCert(name string) (bool, error) {
+	return c.DeleteCertWithContext(context.Background(), name)
+}
+
+func (c *Client) DeleteCertWithContext(ctx context.Context, name string) (bool, error) {
+	cert := Cert{
+		Owner: "admin",
+		Name:  name,
+	}
+	_, affected, err := c.modifyCert(ctx, "delete-cert", &cert)
+	return affected, err
+}
@@ -0,0 +1,73 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import "context"
+
+func GetCerts() ([]*Cert, error) {
+	return globalClient.GetCerts()
+}
+
+func GetCertsWithContext(ctx context.Context) ([]*Cert, error) {
+	return globalClient.GetCertsWithContext(ctx)
+}
+
+func GetPaginationCerts(p int, pageSize int, queryMap map[string]string) ([]*Cert, int, error) {
+	return globalClient.GetPaginationCerts(p, pageSize, queryMap)
+}
+
+func GetPaginationCertsWithContext(ctx context.Context, p int, pageSize int, queryMap map[string]string) ([]*Cert, int, error) {
+	return globalClient.GetPaginationCertsWithContext(ctx, p, pageSize, queryMap)
+}
+
+func GetCert(name string) (*Cert, error) {
+	return globalClient.GetCert(name)
+}
+
+func GetCertWithContext(ctx context.Context, name string) (*Cert, error) {
+	return globalClient.GetCertWithContext(ctx, name)
+}
+
+func GetDefaultCert() (*Cert, error) {
+	return globalClient.GetDefaultCert()
+}
+
+func GetDefaultCertWithContext(ctx context.Context) (*Cert, error) {
+	return globalClient.GetDefaultCertWithContext(ctx)
+}
+
+func UpdateCert(cert *Cert) (bool, error) {
+	return globalClient.UpdateCert(cert)
+}
+
+func UpdateCertWithContext(ctx context.Context, cert *Cert) (bool, error) {
+	return globalClient.UpdateCertWithContext(ctx, cert)
+}
+
+func AddCert(cert *Cert) (bool, error) {
+	return globalClient.AddCert(cert)
+}
+
+func AddCertWithContext(ctx context.Context, cert *Cert) (bool, error) {
+	return globalClient.AddCertWithContext(ctx, cert)
+}
+
+func DeleteCert(name string) (bool, error) {
+	return globalClient.DeleteCert(name)
+}
+
+func DeleteCertWithContext(ctx context.Context, name string) (bool, error) {
+	return globalClient.DeleteCertWithContext(ctx, name)
+}
@@ -0,0 +1,153 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+const defaultCircuitBreakerOpenTimeout = 30 * time.Second
+
+// ErrCircuitOpen is wrapped by the errors of the requests that weren't sent because the circuit breaker is open.
+var ErrCircuitOpen = errors.New("casdoor: circuit breaker open, the server is failing")
+
+// CircuitBreakerOptions configures the circuit breaker of the client, which stops sending requests to a failing
+// server for a while, so that an outage of Casdoor fails the calls fast instead of making them wait for timeouts.
+// Network errors, timeouts and 5xx responses are failures, while the errors reported by the server aren't,
+// and the requests cancelled by their caller are ignored.
+type CircuitBreakerOptions struct {
+	// FailureThreshold is the number of consecutive failures opening the circuit. 0 disables the circuit breaker,
+	// which is the default.
+	FailureThreshold int
+	// OpenTimeout is how long the circuit stays open, failing the requests with ErrCircuitOpen, 30s by default.
+	// Then it is half-open: a single request is sent to probe the server, closing the circuit if it succeeds
+	// and opening it again if it fails.
+	OpenTimeout time.Duration
+}
+
+type circuitState int
+
+const (
+	circuitClosed circuitState = iota
+	circuitOpen
+	circuitHalfOpen
+)
+
+type circuitBreaker struct {
+	options CircuitBreakerOptions
+
+	mu       sync.Mutex
+	state    circuitState
+	failures int
+	openedAt time.Time
+	probing  bool
+}
+
+// SetCircuitBreakerOptions sets the circuit breaker of the client, closed.
+func (c *Client) SetCircuitBreakerOptions(options CircuitBreakerOptions) {
+	if options.OpenTimeout <= 0 {
+		options.OpenTimeout = defaultCircuitBreakerOpenTimeout
+	}
+
+	var breaker *circuitBreaker
+	if options.FailureThreshold > 0 {
+		breaker = &circuitBreaker{options: options}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.circuitBreaker = breaker
+}
+
+func (c *Client) getCircuitBreaker() *circuitBreaker {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.circuitBreaker
+}
+
+// allow reports whether a request may be sent, and whether it is the probe of the half-open state,
+// which is the only request allowed in that state.
+func (b *circuitBreaker) allow(now time.Time) (ok bool, probe bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case circuitOpen:
+		if now.Sub(b.openedAt) < b.options.OpenTimeout {
+			return false, false
+		}
+		b.state = circuitHalfOpen
+		b.probing = true
+		return true, true
+	case circuitHalfOpen:
+		if b.probing {
+			return false, false
+		}
+		b.probing = true
+		return true, true
+	}
+	return true, false
+}
+
+// record records the outcome of a request allowed by allow. Only the probe leaves the half-open state,
+// so the requests sent before the circuit opened don't change it when they end. A request cancelled by
+// its caller tells nothing about the server: it leaves the state unchanged, and a cancelled probe lets
+// the next request probe the server instead.
+func (b *circuitBreaker) record(probe bool, err error, now time.Time) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if probe {
+		b.probing = false
+	}
+	if errors.Is(err, context.Canceled) {
+		return
+	}
+	if b.state != circuitClosed && !probe {
+		return
+	}
+
+	if !isCircuitFailure(err) {
+		b.state = circuitClosed
+		b.failures = 0
+		return
+	}
+
+	b.failures++
+	if probe || b.failures >= b.options.FailureThreshold {
+		b.state = circuitOpen
+		b.openedAt = now
+		b.failures = 0
+	}
+}
+
+// isCircuitFailure reports whether err tells that the server is failing: a transient error other than
+// a rate limit, or a timeout.
+func isCircuitFailure(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var rateLimitErr *RateLimitError
+	if errors.As(err, &rateLimitErr) {
+		return false
+	}
+	return IsRetryable(err) || errors.Is(err, context.DeadlineExceeded)
+}
@@ -0,0 +1,192 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+var errServerFailing = &retryableError{err: errors.New("503 Service Unavailable")}
+
+func newTestCircuitBreaker() *circuitBreaker {
+	return &circuitBreaker{options: CircuitBreakerOptions{FailureThreshold: 2, OpenTimeout: time.Minute}}
+}
+
+// openTestCircuitBreaker returns a breaker whose circuit was opened at now.
+func openTestCircuitBreaker(t *testing.T, now time.Time) *circuitBreaker {
+	t.Helper()
+
+	b := newTestCircuitBreaker()
+	for i := 0; i < 2; i++ {
+		ok, _ := b.allow(now)
+		if !ok {
+			t.Fatalf("request %d isn't allowed by the closed circuit", i)
+		}
+		b.record(false, errServerFailing, now)
+	}
+	if b.state != circuitOpen {
+		t.Fatalf("state = %v after 2 failures, want open", b.state)
+	}
+	return b
+}
+
+func TestCircuitBreakerOpensAfterConsecutiveFailures(t *testing.T) {
+	now := time.Now()
+	b := newTestCircuitBreaker()
+
+	b.record(false, errServerFailing, now)
+	b.record(false, nil, now)
+	b.record(false, errServerFailing, now)
+	if b.state != circuitClosed {
+		t.Fatalf("state = %v after non consecutive failures, want closed", b.state)
+	}
+
+	b.record(false, &APIError{Msg: "the user doesn't exist"}, now)
+	if b.state != circuitClosed {
+		t.Fatalf("state = %v after an error of the server, want closed", b.state)
+	}
+
+	b.record(false, errServerFailing, now)
+	b.record(false, errServerFailing, now)
+	if b.state != circuitOpen {
+		t.Fatalf("state = %v after 2 consecutive failures, want open", b.state)
+	}
+	if ok, _ := b.allow(now.Add(time.Second)); ok {
+		t.Fatal("a request is allowed by the open circuit")
+	}
+}
+
+func TestCircuitBreakerAllowsASingleProbe(t *testing.T) {
+	now := time.Now()
+	b := openTestCircuitBreaker(t, now)
+
+	later := now.Add(time.Minute)
+	ok, probe := b.allow(later)
+	if !ok || !probe {
+		t.Fatalf("allow() = %v, %v after the open timeout, want the probe", ok, probe)
+	}
+	if ok, _ := b.allow(later); ok {
+		t.Fatal("a second request is allowed while probing")
+	}
+}
+
+func TestCircuitBreakerProbeResult(t *testing.T) {
+	tests := []struct {
+		name  string
+		err   error
+		state circuitState
+	}{
+		{"success", nil, circuitClosed},
+		{"error of the server", &APIError{Msg: "the user doesn't exist"}, circuitClosed},
+		{"failure", errServerFailing, circuitOpen},
+		{"timeout", context.DeadlineExceeded, circuitOpen},
+		{"canceled", context.Canceled, circuitHalfOpen},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			now := time.Now()
+			b := openTestCircuitBreaker(t, now)
+
+			later := now.Add(time.Minute)
+			_, probe := b.allow(later)
+			b.record(probe, test.err, later)
+			if b.state != test.state {
+				t.Fatalf("state = %v, want %v", b.state, test.state)
+			}
+			if test.state == circuitOpen && !b.openedAt.Equal(later) {
+				t.Errorf("openedAt = %v, want %v", b.openedAt, later)
+			}
+		})
+	}
+}
+
+func TestCircuitBreakerCanceledProbeLetsTheNextRequestProbe(t *testing.T) {
+	now := time.Now()
+	b := openTestCircuitBreaker(t, now)
+
+	later := now.Add(time.Minute)
+	_, probe := b.allow(later)
+	b.record(probe, context.Canceled, later)
+
+	ok, probe := b.allow(later)
+	if !ok || !probe {
+		t.Fatalf("allow() = %v, %v after a canceled probe, want the probe", ok, probe)
+	}
+}
+
+func TestCircuitBreakerIgnoresRequestsSentBeforeOpening(t *testing.T) {
+	now := time.Now()
+	b := newTestCircuitBreaker()
+
+	// a slow request is sent while the circuit is closed, and ends while the probe is sent
+	_, slow := b.allow(now)
+	b.record(false, errServerFailing, now)
+	b.record(false, errServerFailing, now)
+
+	later := now.Add(time.Minute)
+	_, probe := b.allow(later)
+	b.record(slow, nil, later)
+	if b.state != circuitHalfOpen {
+		t.Fatalf("state = %v after a request sent before opening succeeded, want half-open", b.state)
+	}
+	if ok, _ := b.allow(later); ok {
+		t.Fatal("a second probe is allowed after a request sent before opening ended")
+	}
+
+	b.record(probe, errServerFailing, later)
+	if b.state != circuitOpen {
+		t.Fatalf("state = %v after the probe failed, want open", b.state)
+	}
+
+	// a request sent before opening again doesn't extend the open timeout
+	b.record(false, errServerFailing, later.Add(time.Second))
+	if !b.openedAt.Equal(later) {
+		t.Errorf("openedAt = %v, want %v", b.openedAt, later)
+	}
+}
+
+func TestCircuitBreakerFailsFast(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client := NewClient(&AuthConfig{Endpoint: server.URL, ClientId: "client-id", ClientSecret: "client-secret"})
+	client.SetCircuitBreakerOptions(CircuitBreakerOptions{FailureThreshold: 2, OpenTimeout: time.Minute})
+
+	for i := 0; i < 2; i++ {
+		_, err := client.GetUser("alice")
+		if err == nil || errors.Is(err, ErrCircuitOpen) {
+			t.Fatalf("request %d: err = %v, want the failure of the server", i, err)
+		}
+	}
+
+	_, err := client.GetUser("alice")
+	if !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("err = %v, want ErrCircuitOpen", err)
+	}
+	if n := atomic.LoadInt32(&requests); n != 2 {
+		t.Errorf("the server got %d requests, want 2", n)
+	}
+}
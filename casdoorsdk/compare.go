@@ -0,0 +1,43 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+)
+
+// The checks of secrets, signatures, states and nonces in this package (webhook events, invitation codes,
+// states and ID token nonces) go through the helpers below, so that their response time doesn't leak
+// how much of a value matched.
+
+// secureCompare reports whether a and b are equal, in a time that doesn't depend on their content.
+func secureCompare(a string, b string) bool {
+	return subtle.ConstantTimeCompare([]byte(a), []byte(b)) == 1
+}
+
+// signHmacSha256 returns the hex-encoded HMAC-SHA256 of message with key.
+func signHmacSha256(key []byte, message []byte) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write(message)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// verifyHmacSha256 reports whether signature is the hex-encoded HMAC-SHA256 of message with key.
+func verifyHmacSha256(key []byte, message []byte, signature string) bool {
+	return secureCompare(signHmacSha256(key, message), signature)
+}
@@ -0,0 +1,99 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// UnmarshalTo decodes the user, with its Extra fields, into v, e.g. a struct of the application
+// modeling the custom fields of its users along with the ones it needs from User.
+func (u *User) UnmarshalTo(v interface{}) error {
+	data, err := json.Marshal(u)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+// GetUserAs is GetUser decoding the user into T, a struct of the application, instead of User.
+// c is the client to use, or nil for the one of the package-level functions. It returns nil if the user doesn't exist.
+//
+//	type MyUser struct {
+//		Name       string `json:"name"`
+//		Department string `json:"department"`
+//	}
+//
+//	user, err := casdoorsdk.GetUserAs[MyUser](client, "alice")
+func GetUserAs[T any](c *Client, name string) (*T, error) {
+	return GetUserAsWithContext[T](context.Background(), c, name)
+}
+
+func GetUserAsWithContext[T any](ctx context.Context, c *Client, name string) (*T, error) {
+	if c == nil {
+		c = globalClient
+	}
+	authConfig := c.getAuthConfig()
+
+	queryMap := map[string]string{
+		"id": fmt.Sprintf("%s/%s", authConfig.OrganizationName, name),
+	}
+
+	url := c.GetUrl("get-user", queryMap)
+
+	bytes, err := c.DoGetBytesRawWithContext(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	var user *T
+	err = json.Unmarshal(bytes, &user)
+	if err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// GetUsersAs is GetUsers decoding the users into T, see GetUserAs.
+func GetUsersAs[T any](c *Client) ([]*T, error) {
+	return GetUsersAsWithContext[T](context.Background(), c)
+}
+
+func GetUsersAsWithContext[T any](ctx context.Context, c *Client) ([]*T, error) {
+	if c == nil {
+		c = globalClient
+	}
+	authConfig := c.getAuthConfig()
+
+	queryMap := map[string]string{
+		"owner": authConfig.OrganizationName,
+	}
+
+	url := c.GetUrl("get-users", queryMap)
+
+	bytes, err := c.DoGetBytesRawWithContext(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	var users []*T
+	err = json.Unmarshal(bytes, &users)
+	if err != nil {
+		return nil, err
+	}
+	return users, nil
+}
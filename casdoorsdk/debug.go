@@ -0,0 +1,69 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+)
+
+// SetDebugWriter makes the SDK write a dump of every request and response to w, with the secrets redacted.
+// Pass nil to disable it.
+func (c *Client) SetDebugWriter(w io.Writer) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.debugWriter = w
+}
+
+func (c *Client) getDebugWriter() io.Writer {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.debugWriter
+}
+
+func dumpRequest(w io.Writer, req *http.Request) {
+	var body []byte
+	if req.GetBody != nil {
+		reader, err := req.GetBody()
+		if err == nil {
+			body, _ = ioutil.ReadAll(reader)
+		}
+	}
+
+	fmt.Fprintf(w, "> %s %s\n", req.Method, RedactUrl(req.URL.String()))
+	dumpMessage(w, req.Header, body)
+}
+
+func dumpResponse(w io.Writer, resp *http.Response, body []byte) {
+	fmt.Fprintf(w, "< %s\n", resp.Status)
+	dumpMessage(w, resp.Header, body)
+}
+
+func dumpMessage(w io.Writer, header http.Header, body []byte) {
+	for k, v := range RedactHeader(header) {
+		fmt.Fprintf(w, "%s: %s\n", k, strings.Join(v, ", "))
+	}
+
+	if strings.HasPrefix(header.Get("Content-Type"), "multipart/") {
+		fmt.Fprintf(w, "\n(multipart body of %d bytes)\n\n", len(body))
+		return
+	}
+	fmt.Fprintf(w, "\n%s\n\n", RedactBody(body))
+}
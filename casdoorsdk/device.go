@@ -0,0 +1,211 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/url"
+	"strings"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+const (
+	defaultDevicePollInterval = 5 * time.Second
+	deviceSlowDownInterval    = 5 * time.Second
+)
+
+// ErrDeviceAccessDenied and ErrDeviceCodeExpired are returned by PollDeviceToken when the user denied the
+// authorization, or didn't complete it before the device code expired.
+var (
+	ErrDeviceAccessDenied = errors.New("casdoor: the device authorization was denied")
+	ErrDeviceCodeExpired  = errors.New("casdoor: the device code has expired")
+)
+
+// DeviceAuthorization is the response of the device authorization request of the device flow (RFC 8628).
+// The user is asked to open VerificationUri and enter UserCode, while PollDeviceToken waits for the token.
+type DeviceAuthorization struct {
+	DeviceCode              string `json:"device_code"`
+	UserCode                string `json:"user_code"`
+	VerificationUri         string `json:"verification_uri"`
+	VerificationUriComplete string `json:"verification_uri_complete"`
+	// ExpiresIn is the lifetime of DeviceCode and Interval the minimum delay between polls, in seconds.
+	ExpiresIn int `json:"expires_in"`
+	Interval  int `json:"interval"`
+
+	requestedAt time.Time
+}
+
+// deviceTokenResponse is the response of the token endpoint, holding either a token or an OAuth error.
+type deviceTokenResponse struct {
+	AccessToken      string `json:"access_token"`
+	TokenType        string `json:"token_type"`
+	RefreshToken     string `json:"refresh_token"`
+	ExpiresIn        int    `json:"expires_in"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+// RequestDeviceCode starts the device flow, for the devices without a browser like CLI tools.
+// scope may be empty for the default one of the server.
+func (c *Client) RequestDeviceCode(scope string) (*DeviceAuthorization, error) {
+	return c.RequestDeviceCodeWithContext(context.Background(), scope)
+}
+
+func (c *Client) RequestDeviceCodeWithContext(ctx context.Context, scope string) (*DeviceAuthorization, error) {
+	authConfig := c.getAuthConfig()
+
+	form := url.Values{"client_id": {authConfig.ClientId}}
+	if scope != "" {
+		form.Set("scope", scope)
+	}
+
+	bytes, err := c.postForm(ctx, "device-auth", form)
+	if err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		DeviceAuthorization
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}
+	err = json.Unmarshal(bytes, &response)
+	if err != nil {
+		return nil, err
+	}
+	if response.Error != "" {
+		return nil, c.newOAuthError(response.Error, response.ErrorDescription)
+	}
+
+	deviceAuthorization := response.DeviceAuthorization
+	deviceAuthorization.requestedAt = time.Now()
+	return &deviceAuthorization, nil
+}
+
+// PollDeviceToken polls the token endpoint until the user completes the authorization started by RequestDeviceCode,
+// and returns the token. It fails with ErrDeviceAccessDenied or ErrDeviceCodeExpired, or when ctx is done.
+func (c *Client) PollDeviceToken(ctx context.Context, deviceAuthorization *DeviceAuthorization) (*oauth2.Token, error) {
+	authConfig := c.getAuthConfig()
+
+	form := url.Values{
+		"grant_type":  {"urn:ietf:params:oauth:grant-type:device_code"},
+		"client_id":   {authConfig.ClientId},
+		"device_code": {deviceAuthorization.DeviceCode},
+	}
+
+	interval := time.Duration(deviceAuthorization.Interval) * time.Second
+	if interval <= 0 {
+		interval = defaultDevicePollInterval
+	}
+
+	var expiresAt time.Time
+	if deviceAuthorization.ExpiresIn > 0 && !deviceAuthorization.requestedAt.IsZero() {
+		expiresAt = deviceAuthorization.requestedAt.Add(time.Duration(deviceAuthorization.ExpiresIn) * time.Second)
+	}
+
+	for {
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+
+		if !expiresAt.IsZero() && time.Now().After(expiresAt) {
+			return nil, ErrDeviceCodeExpired
+		}
+
+		bytes, err := c.postForm(ctx, "login/oauth/access_token", form)
+		if err != nil {
+			if IsRetryable(err) {
+				continue
+			}
+			return nil, err
+		}
+
+		var response deviceTokenResponse
+		err = json.Unmarshal(bytes, &response)
+		if err != nil {
+			return nil, err
+		}
+
+		switch response.Error {
+		case "":
+			return c.getDeviceToken(&response, bytes)
+		case "authorization_pending":
+		case "slow_down":
+			interval += deviceSlowDownInterval
+		case "access_denied":
+			return nil, ErrDeviceAccessDenied
+		case "expired_token":
+			return nil, ErrDeviceCodeExpired
+		default:
+			return nil, c.newOAuthError(response.Error, response.ErrorDescription)
+		}
+	}
+}
+
+// getDeviceToken returns the token of a successful response of the token endpoint, with its raw fields as extras.
+func (c *Client) getDeviceToken(response *deviceTokenResponse, bytes []byte) (*oauth2.Token, error) {
+	token := &oauth2.Token{
+		AccessToken:  response.AccessToken,
+		TokenType:    response.TokenType,
+		RefreshToken: response.RefreshToken,
+	}
+	if response.ExpiresIn > 0 {
+		token.Expiry = time.Now().Add(time.Duration(response.ExpiresIn) * time.Second)
+	}
+
+	err := c.getOAuthTokenError(token)
+	if err != nil {
+		return nil, err
+	}
+
+	var extra map[string]interface{}
+	err = json.Unmarshal(bytes, &extra)
+	if err != nil {
+		return nil, err
+	}
+	return token.WithExtra(extra), nil
+}
+
+// postForm posts form to the action and returns the body of the response, whatever its status,
+// since the OAuth endpoints report their errors with a 400 status. Error Responses are returned as APIErrors.
+func (c *Client) postForm(ctx context.Context, action string, form url.Values) ([]byte, error) {
+	url := c.GetUrl(action, nil)
+	bytes, statusCode, err := c.doPost(ctx, url, "application/x-www-form-urlencoded", strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.checkErrorResponse("POST", url, statusCode, bytes)
+	if err != nil {
+		return nil, err
+	}
+	return bytes, nil
+}
+
+// newOAuthError returns the APIError of an OAuth error response.
+func (c *Client) newOAuthError(code string, description string) *APIError {
+	if description == "" {
+		return c.newAPIError(code)
+	}
+	return c.newAPIError(code + ": " + description)
+}
@@ -0,0 +1,110 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// diffIgnoredFields are the volatile fields that are updated by the server on its own and never compared by Diff.
+var diffIgnoredFields = map[string]bool{
+	"createdTime":         true,
+	"updatedTime":         true,
+	"lastSigninTime":      true,
+	"lastSigninIp":        true,
+	"lastSigninWrongTime": true,
+	"signinWrongTimes":    true,
+}
+
+// FieldDiff is a field whose value differs between two objects, see Diff.
+type FieldDiff struct {
+	// Field is the JSON name of the field.
+	Field  string
+	Local  interface{}
+	Remote interface{}
+}
+
+func (d *FieldDiff) String() string {
+	return fmt.Sprintf("%s: %s -> %s", d.Field, formatDiffValue(d.Local), formatDiffValue(d.Remote))
+}
+
+// ObjectDiff is the list of differences between two objects, sorted by field.
+type ObjectDiff []*FieldDiff
+
+// String returns a human-readable report of the differences, one field per line.
+func (d ObjectDiff) String() string {
+	lines := make([]string, len(d))
+	for i, fieldDiff := range d {
+		lines[i] = fieldDiff.String()
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Diff returns the fields of local that differ from remote, e.g. to check that a sync converged or to report drift.
+// The fields are compared by their JSON values, so that an empty list equals a missing one, and the volatile fields
+// the server updates on its own, like updatedTime or lastSigninTime, are ignored.
+func Diff[T *User | *Role | *Permission | *Application](local T, remote T) (ObjectDiff, error) {
+	localFields, err := getObjectFields(local)
+	if err != nil {
+		return nil, err
+	}
+
+	remoteFields, err := getObjectFields(remote)
+	if err != nil {
+		return nil, err
+	}
+
+	return diffFields(localFields, remoteFields), nil
+}
+
+func diffFields(localFields map[string]interface{}, remoteFields map[string]interface{}) ObjectDiff {
+	names := map[string]bool{}
+	for name := range localFields {
+		names[name] = true
+	}
+	for name := range remoteFields {
+		names[name] = true
+	}
+
+	var diff ObjectDiff
+	for name := range names {
+		if diffIgnoredFields[name] {
+			continue
+		}
+
+		localValue := normalizeEmpty(localFields[name])
+		remoteValue := normalizeEmpty(remoteFields[name])
+		if !reflect.DeepEqual(localValue, remoteValue) {
+			diff = append(diff, &FieldDiff{Field: name, Local: localValue, Remote: remoteValue})
+		}
+	}
+
+	sort.Slice(diff, func(i, j int) bool {
+		return diff[i].Field < diff[j].Field
+	})
+	return diff
+}
+
+func formatDiffValue(value interface{}) string {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(data)
+}
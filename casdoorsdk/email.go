@@ -15,8 +15,9 @@
 package casdoorsdk
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
+	"errors"
 )
 
 type emailForm struct {
@@ -26,7 +27,18 @@ type emailForm struct {
 	Receivers []string `json:"receivers"`
 }
 
-func SendEmail(title string, content string, sender string, receivers ...string) error {
+// SendEmail sends an email to receivers through the email provider of the application.
+// sender is the name the email is sent from, the one of the provider if empty.
+// If some receivers aren't valid email addresses, the error is an APIError with the ErrorCodeInvalidEmail code.
+func (c *Client) SendEmail(title string, content string, sender string, receivers ...string) error {
+	return c.SendEmailWithContext(context.Background(), title, content, sender, receivers...)
+}
+
+func (c *Client) SendEmailWithContext(ctx context.Context, title string, content string, sender string, receivers ...string) error {
+	if len(receivers) == 0 {
+		return errors.New("casdoor: the email has no receivers")
+	}
+
 	form := emailForm{
 		Title:     title,
 		Content:   content,
@@ -38,14 +50,6 @@ func SendEmail(title string, content string, sender string, receivers ...string)
 		return err
 	}
 
-	resp, err := DoPost("send-email", nil, postBytes, false, false)
-	if err != nil {
-		return err
-	}
-
-	if resp.Status != "ok" {
-		return fmt.Errorf(resp.Msg)
-	}
-
-	return nil
+	_, err = c.DoPostWithContext(ctx, "send-email", nil, postBytes, false, false)
+	return err
 }
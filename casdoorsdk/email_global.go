@@ -0,0 +1,25 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import "context"
+
+func SendEmail(title string, content string, sender string, receivers ...string) error {
+	return globalClient.SendEmail(title, content, sender, receivers...)
+}
+
+func SendEmailWithContext(ctx context.Context, title string, content string, sender string, receivers ...string) error {
+	return globalClient.SendEmailWithContext(ctx, title, content, sender, receivers...)
+}
@@ -15,8 +15,10 @@
 package casdoorsdk
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 )
 
 type PermissionRule struct {
@@ -32,19 +34,39 @@ type PermissionRule struct {
 
 type CasbinRequest = []interface{}
 
-func Enforce(permissionId, modelId, resourceId string, casbinRequest CasbinRequest) (bool, error) {
+// Enforce asks the server whether casbinRequest, like {"alice", "data1", "read"}, is allowed by the permission,
+// or by the permissions of the model or the resource when permissionId is empty.
+func (c *Client) Enforce(permissionId, modelId, resourceId string, casbinRequest CasbinRequest) (bool, error) {
+	return c.EnforceWithContext(context.Background(), permissionId, modelId, resourceId, casbinRequest)
+}
+
+func (c *Client) EnforceWithContext(ctx context.Context, permissionId, modelId, resourceId string, casbinRequest CasbinRequest) (bool, error) {
+	return c.enforce(ctx, getEnforceQueryMap(permissionId, modelId, resourceId), casbinRequest)
+}
+
+// EnforceByEnforcer asks the server whether casbinRequest is allowed by the enforcer named enforcerName,
+// which is managed with the Enforcer functions.
+func (c *Client) EnforceByEnforcer(enforcerName string, casbinRequest CasbinRequest) (bool, error) {
+	return c.EnforceByEnforcerWithContext(context.Background(), enforcerName, casbinRequest)
+}
+
+func (c *Client) EnforceByEnforcerWithContext(ctx context.Context, enforcerName string, casbinRequest CasbinRequest) (bool, error) {
+	return c.enforce(ctx, c.getEnforcerQueryMap(enforcerName), casbinRequest)
+}
+
+func (c *Client) enforce(ctx context.Context, queryMap map[string]string, casbinRequest CasbinRequest) (bool, error) {
 	postBytes, err := json.Marshal(casbinRequest)
 	if err != nil {
 		return false, err
 	}
 
-	res, err := doEnforce("enforce", permissionId, modelId, resourceId, postBytes)
+	res, err := c.doEnforce(ctx, "enforce", queryMap, postBytes)
 	if err != nil {
 		return false, err
 	}
 
 	data, ok := res.Data.([]interface{})
-	if !ok {
+	if !ok || len(data) == 0 {
 		return false, errors.New("invalid data")
 	}
 
@@ -56,13 +78,31 @@ func Enforce(permissionId, modelId, resourceId string, casbinRequest CasbinReque
 	return allow, nil
 }
 
-func BatchEnforce(permissionId, modelId, resourceId string, casbinRequests []CasbinRequest) ([][]bool, error) {
+// BatchEnforce is Enforce for several requests at once, it returns the results of each of them.
+func (c *Client) BatchEnforce(permissionId, modelId, resourceId string, casbinRequests []CasbinRequest) ([][]bool, error) {
+	return c.BatchEnforceWithContext(context.Background(), permissionId, modelId, resourceId, casbinRequests)
+}
+
+func (c *Client) BatchEnforceWithContext(ctx context.Context, permissionId, modelId, resourceId string, casbinRequests []CasbinRequest) ([][]bool, error) {
+	return c.batchEnforce(ctx, getEnforceQueryMap(permissionId, modelId, resourceId), casbinRequests)
+}
+
+// BatchEnforceByEnforcer is EnforceByEnforcer for several requests at once, it returns the results of each of them.
+func (c *Client) BatchEnforceByEnforcer(enforcerName string, casbinRequests []CasbinRequest) ([][]bool, error) {
+	return c.BatchEnforceByEnforcerWithContext(context.Background(), enforcerName, casbinRequests)
+}
+
+func (c *Client) BatchEnforceByEnforcerWithContext(ctx context.Context, enforcerName string, casbinRequests []CasbinRequest) ([][]bool, error) {
+	return c.batchEnforce(ctx, c.getEnforcerQueryMap(enforcerName), casbinRequests)
+}
+
+func (c *Client) batchEnforce(ctx context.Context, queryMap map[string]string, casbinRequests []CasbinRequest) ([][]bool, error) {
 	postBytes, err := json.Marshal(casbinRequests)
 	if err != nil {
 		return nil, err
 	}
 
-	res, err := doEnforce("batch-enforce", permissionId, modelId, resourceId, postBytes)
+	res, err := c.doEnforce(ctx, "batch-enforce", queryMap, postBytes)
 	if err != nil {
 		return nil, err
 	}
@@ -92,15 +132,25 @@ func BatchEnforce(permissionId, modelId, resourceId string, casbinRequests []Cas
 	return allows, nil
 }
 
-func doEnforce(action string, permissionId, modelId, resourceId string, postBytes []byte) (*Response, error) {
-	queryMap := map[string]string{
+func getEnforceQueryMap(permissionId, modelId, resourceId string) map[string]string {
+	return map[string]string{
 		"permissionId": permissionId,
 		"modelId":      modelId,
 		"resourceId":   resourceId,
 	}
+}
+
+func (c *Client) getEnforcerQueryMap(enforcerName string) map[string]string {
+	authConfig := c.getAuthConfig()
+
+	return map[string]string{
+		"enforcerId": fmt.Sprintf("%s/%s", authConfig.OrganizationName, enforcerName),
+	}
+}
 
-	//bytes, err := DoPostBytesRaw(url, "", bytes.NewBuffer(postBytes))
-	resp, err := DoPost(action, queryMap, postBytes, false, false)
+func (c *Client) doEnforce(ctx context.Context, action string, queryMap map[string]string, postBytes []byte) (*Response, error) {
+	//bytes, err := DoPostBytesRawWithContext(ctx, url, "", bytes.NewBuffer(postBytes))
+	resp, err := c.DoPostWithContext(ctx, action, queryMap, postBytes, false, false)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,49 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import "context"
+
+func Enforce(permissionId, modelId, resourceId string, casbinRequest CasbinRequest) (bool, error) {
+	return globalClient.Enforce(permissionId, modelId, resourceId, casbinRequest)
+}
+
+func EnforceWithContext(ctx context.Context, permissionId, modelId, resourceId string, casbinRequest CasbinRequest) (bool, error) {
+	return globalClient.EnforceWithContext(ctx, permissionId, modelId, resourceId, casbinRequest)
+}
+
+func EnforceByEnforcer(enforcerName string, casbinRequest CasbinRequest) (bool, error) {
+	return globalClient.EnforceByEnforcer(enforcerName, casbinRequest)
+}
+
+func EnforceByEnforcerWithContext(ctx context.Context, enforcerName string, casbinRequest CasbinRequest) (bool, error) {
+	return globalClient.EnforceByEnforcerWithContext(ctx, enforcerName, casbinRequest)
+}
+
+func BatchEnforce(permissionId, modelId, resourceId string, casbinRequests []CasbinRequest) ([][]bool, error) {
+	return globalClient.BatchEnforce(permissionId, modelId, resourceId, casbinRequests)
+}
+
+func BatchEnforceWithContext(ctx context.Context, permissionId, modelId, resourceId string, casbinRequests []CasbinRequest) ([][]bool, error) {
+	return globalClient.BatchEnforceWithContext(ctx, permissionId, modelId, resourceId, casbinRequests)
+}
+
+func BatchEnforceByEnforcer(enforcerName string, casbinRequests []CasbinRequest) ([][]bool, error) {
+	return globalClient.BatchEnforceByEnforcer(enforcerName, casbinRequests)
+}
+
+func BatchEnforceByEnforcerWithContext(ctx context.Context, enforcerName string, casbinRequests []CasbinRequest) ([][]bool, error) {
+	return globalClient.BatchEnforceByEnforcerWithContext(ctx, enforcerName, casbinRequests)
+}
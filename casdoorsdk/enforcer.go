@@ -0,0 +1,149 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// Enforcer has the same definition as https://github.com/casdoor/casdoor/blob/master/object/enforcer.go#L26
+type Enforcer struct {
+	Owner       string `xorm:"varchar(100) notnull pk" json:"owner"`
+	Name        string `xorm:"varchar(100) notnull pk" json:"name"`
+	CreatedTime string `xorm:"varchar(100)" json:"createdTime"`
+	UpdatedTime string `xorm:"varchar(100) updated" json:"updatedTime"`
+	DisplayName string `xorm:"varchar(100)" json:"displayName"`
+	Description string `xorm:"varchar(100)" json:"description"`
+
+	// Model and Adapter are the ids ("owner/name") of the model and the adapter of the enforcer.
+	Model     string `xorm:"varchar(100)" json:"model"`
+	Adapter   string `xorm:"varchar(100)" json:"adapter"`
+	IsEnabled bool   `json:"isEnabled"`
+}
+
+func (c *Client) GetEnforcers() ([]*Enforcer, error) {
+	return c.GetEnforcersWithContext(context.Background())
+}
+
+func (c *Client) GetEnforcersWithContext(ctx context.Context) ([]*Enforcer, error) {
+	authConfig := c.getAuthConfig()
+
+	queryMap := map[string]string{
+		"owner": authConfig.OrganizationName,
+	}
+
+	url := c.GetUrl("get-enforcers", queryMap)
+
+	bytes, err := c.DoGetBytesWithContext(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	var enforcers []*Enforcer
+	err = c.unmarshal(bytes, &enforcers)
+	if err != nil {
+		return nil, err
+	}
+	return enforcers, nil
+}
+
+func (c *Client) GetPaginationEnforcers(p int, pageSize int, queryMap map[string]string) ([]*Enforcer, int, error) {
+	return c.GetPaginationEnforcersWithContext(context.Background(), p, pageSize, queryMap)
+}
+
+func (c *Client) GetPaginationEnforcersWithContext(ctx context.Context, p int, pageSize int, queryMap map[string]string) ([]*Enforcer, int, error) {
+	authConfig := c.getAuthConfig()
+
+	queryMap = getPaginationQueryMap(authConfig.OrganizationName, p, pageSize, queryMap)
+
+	url := c.GetUrl("get-enforcers", queryMap)
+
+	response, err := c.DoGetResponseWithContext(ctx, url)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	bytes, err := json.Marshal(response.Data)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var enforcers []*Enforcer
+	err = c.unmarshal(bytes, &enforcers)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	count, err := getCount(response)
+	if err != nil {
+		return nil, 0, err
+	}
+	return enforcers, count, nil
+}
+
+func (c *Client) GetEnforcer(name string) (*Enforcer, error) {
+	return c.GetEnforcerWithContext(context.Background(), name)
+}
+
+func (c *Client) GetEnforcerWithContext(ctx context.Context, name string) (*Enforcer, error) {
+	authConfig := c.getAuthConfig()
+
+	queryMap := map[string]string{
+		"id": fmt.Sprintf("%s/%s", authConfig.OrganizationName, name),
+	}
+
+	url := c.GetUrl("get-enforcer", queryMap)
+
+	bytes, err := c.DoGetBytesWithContext(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	var enforcer *Enforcer
+	err = c.unmarshal(bytes, &enforcer)
+	if err != nil {
+		return nil, err
+	}
+	return enforcer, nil
+}
+
+func (c *Client) UpdateEnforcer(enforcer *Enforcer) (bool, error) {
+	return c.UpdateEnforcerWithContext(context.Background(), enforcer)
+}
+
+func (c *Client) UpdateEnforcerWithContext(ctx context.Context, enforcer *Enforcer) (bool, error) {
+	_, affected, err := c.modifyEnforcer(ctx, "update-enforcer", enforcer, nil)
+	return affected, err
+}
+
+func (c *Client) AddEnforcer(enforcer *Enforcer) (bool, error) {
+	return c.AddEnforcerWithContext(context.Background(), enforcer)
+}
+
+func (c *Client) AddEnforcerWithContext(ctx context.Context, enforcer *Enforcer) (bool, error) {
+	_, affected, err := c.modifyEnforcer(ctx, "add-enforcer", enforcer, nil)
+	return affected, err
+}
+
+func (c *Client) DeleteEnforcer(enforcer *Enforcer) (bool, error) {
+	return c.DeleteEnforcerWithContext(context.Background(), enforcer)
+}
+
+func (c *Client) DeleteEnforcerWithContext(ctx context.Context, enforcer *Enforcer) (bool, error) {
+	_, affected, err := c.modifyEnforcer(ctx, "delete-enforcer", enforcer, nil)
+	return affected, err
+}
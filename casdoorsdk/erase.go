@@ -0,0 +1,201 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+)
+
+// EraseOptions configures EraseUser.
+type EraseOptions struct {
+	// Anonymize keeps the user, with their personal data wiped and sign-in forbidden, instead of deleting it,
+	// e.g. to keep the records that refer to it consistent.
+	Anonymize bool
+	// KeepResources keeps the files uploaded by the user.
+	KeepResources bool
+}
+
+// EraseReport lists everything EraseUser changed, by name.
+type EraseReport struct {
+	User        string
+	Tokens      []string
+	Roles       []string
+	Permissions []string
+	Resources   []string
+	Deleted     bool
+	Anonymized  bool
+}
+
+// EraseUser erases the user of the organization to fulfill a "right to be forgotten" request: it revokes their tokens
+// in all applications, removes them from the roles and permissions, deletes the files they uploaded and finally
+// deletes or anonymizes the user. The user is removed from their groups along with their data.
+// If a step fails, EraseUser stops and returns the error along with the report of what was already changed,
+// so that it can be called again.
+func (c *Client) EraseUser(name string, options *EraseOptions) (*EraseReport, error) {
+	return c.EraseUserWithContext(context.Background(), name, options)
+}
+
+func (c *Client) EraseUserWithContext(ctx context.Context, name string, options *EraseOptions) (*EraseReport, error) {
+	if options == nil {
+		options = &EraseOptions{}
+	}
+
+	user, err := c.GetUserWithContext(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, c.newAPIError(fmt.Sprintf("the user: %s doesn't exist", name))
+	}
+
+	userId := fmt.Sprintf("%s/%s", user.Owner, user.Name)
+	report := &EraseReport{User: userId}
+
+	report.Tokens, err = c.deleteUserTokens(ctx, user.Owner, user.Name, "")
+	if err != nil {
+		return report, err
+	}
+
+	roles := c.IterateRolesWithContext(ctx, nil)
+	for roles.Next() {
+		role := roles.Value()
+		users, ok := removeString(role.Users, userId)
+		if !ok {
+			continue
+		}
+
+		role.Users = users
+		_, err = c.UpdateRoleForColumnsWithContext(ctx, role, []string{"users"})
+		if err != nil {
+			return report, err
+		}
+		report.Roles = append(report.Roles, role.Name)
+	}
+	if roles.Err() != nil {
+		return report, roles.Err()
+	}
+
+	permissions := c.IteratePermissionsWithContext(ctx, nil)
+	for permissions.Next() {
+		permission := permissions.Value()
+		users, ok := removeString(permission.Users, userId)
+		if !ok {
+			continue
+		}
+
+		permission.Users = users
+		_, err = c.UpdatePermissionForColumnsWithContext(ctx, permission, []string{"users"})
+		if err != nil {
+			return report, err
+		}
+		report.Permissions = append(report.Permissions, permission.Name)
+	}
+	if permissions.Err() != nil {
+		return report, permissions.Err()
+	}
+
+	if !options.KeepResources {
+		report.Resources, err = c.deleteUserResources(ctx, user.Name)
+		if err != nil {
+			return report, err
+		}
+	}
+
+	if options.Anonymize {
+		anonymousUser, err := getAnonymousUser(user)
+		if err != nil {
+			return report, err
+		}
+
+		_, err = c.UpdateUserWithContext(ctx, anonymousUser)
+		if err != nil {
+			return report, err
+		}
+		report.Anonymized = true
+		return report, nil
+	}
+
+	_, err = c.DeleteUserWithContext(ctx, user)
+	if err != nil {
+		return report, err
+	}
+	report.Deleted = true
+	return report, nil
+}
+
+// deleteUserResources deletes the resources uploaded by the user and returns their names.
+func (c *Client) deleteUserResources(ctx context.Context, name string) ([]string, error) {
+	// the resources are all listed first, since deleting them shifts the pages
+	var resources []*Resource
+	for page := 1; ; page++ {
+		pageResources, count, err := c.GetPaginationResourcesWithContext(ctx, page, maxPageSize, map[string]string{"user": name})
+		if err != nil {
+			return nil, err
+		}
+
+		resources = append(resources, pageResources...)
+		if page*maxPageSize >= count {
+			break
+		}
+	}
+
+	var names []string
+	for _, resource := range resources {
+		if resource.User != name {
+			continue
+		}
+
+		_, err := c.DeleteResourceWithContext(ctx, resource.Name)
+		if err != nil {
+			return names, err
+		}
+		names = append(names, resource.Name)
+	}
+	return names, nil
+}
+
+// getAnonymousUser returns user with all their personal data wiped, a random password and sign-in forbidden.
+func getAnonymousUser(user *User) (*User, error) {
+	password := make([]byte, 32)
+	_, err := rand.Read(password)
+	if err != nil {
+		return nil, err
+	}
+
+	return &User{
+		Owner:       user.Owner,
+		Name:        user.Name,
+		CreatedTime: user.CreatedTime,
+		Id:          user.Id,
+		Type:        user.Type,
+		Password:    hex.EncodeToString(password),
+		DisplayName: "Deleted user",
+		IsForbidden: true,
+	}, nil
+}
+
+// removeString returns values without value, and whether it was found.
+func removeString(values []string, value string) ([]string, bool) {
+	res := make([]string, 0, len(values))
+	for _, v := range values {
+		if v != value {
+			res = append(res, v)
+		}
+	}
+	return res, len(res) != len(values)
+}
@@ -0,0 +1,357 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// ErrorCode is a stable identifier of an error reported by the Casdoor server,
+// so that callers don't have to match the (possibly localized) server messages.
+type ErrorCode string
+
+const (
+	ErrorCodeUnknown       ErrorCode = ""
+	ErrorCodeNotFound      ErrorCode = "NotFound"
+	ErrorCodeUserNotExist  ErrorCode = "UserNotExist"
+	ErrorCodeWrongPassword ErrorCode = "WrongPassword"
+	// ErrorCodeWeakPassword is returned when a new password doesn't meet the password complexity options of the organization.
+	ErrorCodeWeakPassword  ErrorCode = "WeakPassword"
+	ErrorCodeAccountLocked ErrorCode = "AccountLocked"
+	ErrorCodeUserForbidden ErrorCode = "UserForbidden"
+	ErrorCodeWrongCode     ErrorCode = "WrongCode"
+	ErrorCodeCodeExpired   ErrorCode = "CodeExpired"
+	ErrorCodeInvalidClient ErrorCode = "InvalidClient"
+	ErrorCodeInvalidToken  ErrorCode = "InvalidToken"
+	ErrorCodeUnauthorized  ErrorCode = "Unauthorized"
+	ErrorCodeCaptchaFailed ErrorCode = "CaptchaFailed"
+	// ErrorCodeInvalidEmail is returned by SendEmail when some receivers aren't valid email addresses.
+	ErrorCodeInvalidEmail ErrorCode = "InvalidEmail"
+	// ErrorCodeInvalidPhone is returned by SendSms when some receivers aren't valid phone numbers
+	// for the country codes of the organization.
+	ErrorCodeInvalidPhone ErrorCode = "InvalidPhone"
+)
+
+// ErrInvalidRedirectUri is wrapped by the errors of CheckRedirectUri.
+var ErrInvalidRedirectUri = errors.New("casdoor: the redirect uri isn't registered in the application")
+
+// ErrNotFound and ErrUnauthorized match the APIErrors of their kind with errors.Is, e.g. to tell
+// "the user doesn't exist" from "the token has expired" without parsing the message of the server.
+var (
+	ErrNotFound     = errors.New("casdoor: not found")
+	ErrUnauthorized = errors.New("casdoor: unauthorized")
+)
+
+// errorCodePatterns maps fragments of known server messages to error codes.
+// The patterns are matched case-insensitively and in order, so more specific patterns come first.
+var errorCodePatterns = []struct {
+	pattern string
+	code    ErrorCode
+}{
+	{"too many times", ErrorCodeAccountLocked},
+	{"is forbidden to sign in", ErrorCodeUserForbidden},
+	{"the user:", ErrorCodeUserNotExist},
+	{"user doesn't exist", ErrorCodeUserNotExist},
+	{"用户不存在", ErrorCodeUserNotExist},
+	{"password must", ErrorCodeWeakPassword},
+	{"old password is wrong", ErrorCodeWrongPassword},
+	{"password or code is incorrect", ErrorCodeWrongPassword},
+	{"password is incorrect", ErrorCodeWrongPassword},
+	{"密码错误", ErrorCodeWrongPassword},
+	{"turing test failed", ErrorCodeCaptchaFailed},
+	{"captcha", ErrorCodeCaptchaFailed},
+	{"code has expired", ErrorCodeCodeExpired},
+	{"code is expired", ErrorCodeCodeExpired},
+	{"verification code", ErrorCodeWrongCode},
+	{"code is incorrect", ErrorCodeWrongCode},
+	{"invalid client_id", ErrorCodeInvalidClient},
+	{"wrong clientsecret", ErrorCodeInvalidClient},
+	{"invalid_client", ErrorCodeInvalidClient},
+	{"token has expired", ErrorCodeInvalidToken},
+	{"invalid token", ErrorCodeInvalidToken},
+	{"invalid_grant", ErrorCodeInvalidToken},
+	{"invalid email receivers", ErrorCodeInvalidEmail},
+	{"invalid phone receivers", ErrorCodeInvalidPhone},
+	{"unauthorized operation", ErrorCodeUnauthorized},
+	{"please login first", ErrorCodeUnauthorized},
+	{"please sign in first", ErrorCodeUnauthorized},
+	{"don't have the permission", ErrorCodeUnauthorized},
+	{"doesn't exist", ErrorCodeNotFound},
+	{"does not exist", ErrorCodeNotFound},
+}
+
+// APIError is returned when the Casdoor server answers a request with an error.
+type APIError struct {
+	// Code identifies the error independently of the language of Msg.
+	// It is ErrorCodeUnknown for the messages the SDK doesn't know, including localized ones it has no pattern for.
+	Code ErrorCode
+	// Msg is the message of the server, in the language set with SetLanguage.
+	Msg string
+	// Lang is the language set with SetLanguage when the error was returned, or empty for the server default.
+	Lang string
+
+	// StatusCode is the HTTP status of the response, usually 200 since the server reports most errors in the body.
+	// It and the fields below are zero when the error wasn't decoded from a Response.
+	StatusCode int
+	Method     string
+	Action     string
+	// Url is the target of the request, with the secrets in its query redacted.
+	Url string
+	// Body is the body of the response, with the values of its sensitive fields masked, see RedactBody.
+	Body []byte
+}
+
+// CasdoorError is another name of APIError.
+type CasdoorError = APIError
+
+func (e *APIError) Error() string {
+	return e.Msg
+}
+
+// Is makes errors.Is(err, ErrNotFound) and errors.Is(err, ErrUnauthorized) match the APIErrors of their kind.
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.Code == ErrorCodeNotFound || e.Code == ErrorCodeUserNotExist || e.StatusCode == http.StatusNotFound
+	case ErrUnauthorized:
+		return e.Code == ErrorCodeUnauthorized || e.Code == ErrorCodeInvalidToken || e.Code == ErrorCodeInvalidClient ||
+			e.StatusCode == http.StatusUnauthorized || e.StatusCode == http.StatusForbidden
+	}
+	return false
+}
+
+func (c *Client) newAPIError(msg string) *APIError {
+	return &APIError{
+		Code: getErrorCode(msg),
+		Msg:  msg,
+		Lang: c.getLanguage(),
+	}
+}
+
+// newResponseError returns the APIError of a Response whose status isn't "ok".
+func (c *Client) newResponseError(method string, url string, statusCode int, body []byte, msg string) *APIError {
+	e := c.newAPIError(msg)
+	e.StatusCode = statusCode
+	e.Method = method
+	e.Action = getAction(url)
+	e.Url = RedactUrl(url)
+	e.Body = RedactBody(body)
+	return e
+}
+
+// getErrorCode returns the code of a server message, or ErrorCodeUnknown if the message isn't known.
+func getErrorCode(msg string) ErrorCode {
+	msg = strings.ToLower(msg)
+	for _, p := range errorCodePatterns {
+		if strings.Contains(msg, p.pattern) {
+			return p.code
+		}
+	}
+	return ErrorCodeUnknown
+}
+
+// RequestError is returned when a request to the Casdoor server fails before a response could be decoded.
+// It tells which call failed, since errors like "invalid character '<'" are meaningless on their own.
+type RequestError struct {
+	Method string
+	Action string
+	// Url is the target of the request, with the secrets in its query redacted.
+	Url string
+	Err error
+}
+
+func (e *RequestError) Error() string {
+	return fmt.Sprintf("casdoor: %s failed (%s %s): %v", e.Action, e.Method, e.Url, e.Err)
+}
+
+func (e *RequestError) Unwrap() error {
+	return e.Err
+}
+
+func newRequestError(method string, url string, err error) *RequestError {
+	return &RequestError{
+		Method: method,
+		Action: getAction(url),
+		Url:    RedactUrl(url),
+		Err:    err,
+	}
+}
+
+// retryableError marks an error as transient, see IsRetryable.
+type retryableError struct {
+	err error
+	// retryAfter is how long the server asked to wait before retrying, see GetRetryAfter.
+	retryAfter time.Duration
+}
+
+func (e *retryableError) Error() string {
+	return e.err.Error()
+}
+
+func (e *retryableError) Unwrap() error {
+	return e.err
+}
+
+// IsRetryable reports whether err is transient, i.e. whether the failed request may succeed if it is sent again.
+// Transient network errors, like timeouts and refused or reset connections, and 5xx or 429 responses are retryable,
+// while errors reported by the server (e.g. validation errors), other 4xx responses and the other transport errors,
+// like an unsupported scheme or an untrusted certificate, are permanent.
+func IsRetryable(err error) bool {
+	var e *retryableError
+	return errors.As(err, &e)
+}
+
+// classifyTransportError marks the transient network errors returned by the http client as retryable.
+func classifyTransportError(err error) error {
+	// the caller gave up on the request, sending it again wouldn't help
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return err
+	}
+
+	if isTransientNetworkError(err) {
+		return &retryableError{err: err}
+	}
+	return err
+}
+
+// isTransientNetworkError reports whether err is a timeout, a failure to connect, a connection reset or refused,
+// or a connection closed before the end of the response. The other errors, like an unsupported scheme,
+// a malformed url or a certificate that can't be verified, would fail again.
+func isTransientNetworkError(err error) bool {
+	// *url.Error implements net.Error itself, so it must be looked through
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		err = urlErr.Err
+	}
+
+	var unknownAuthorityErr x509.UnknownAuthorityError
+	var certificateInvalidErr x509.CertificateInvalidError
+	var hostnameErr x509.HostnameError
+	if errors.As(err, &unknownAuthorityErr) || errors.As(err, &certificateInvalidErr) || errors.As(err, &hostnameErr) {
+		return false
+	}
+
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) {
+		return true
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && (opErr.Op == "dial" || opErr.Op == "read")
+}
+
+// maxBodySnippetLength is the length of the body kept by HTTPStatusError.
+const maxBodySnippetLength = 512
+
+// HTTPStatusError is returned, wrapped in a RequestError, when the server, or a proxy in front of it, answers
+// a request with a non-2xx status and a body that isn't an error of Casdoor, like the HTML page of a 502.
+// The statuses 5xx are retryable, see IsRetryable, and 429 is a RateLimitError instead.
+type HTTPStatusError struct {
+	StatusCode int
+	Status     string
+	Method     string
+	Action     string
+	// Url is the target of the request, with the secrets in its query redacted.
+	Url string
+	// Body is the beginning of the body of the response, with the values of its sensitive fields masked.
+	Body string
+}
+
+func (e *HTTPStatusError) Error() string {
+	if e.Body == "" {
+		return fmt.Sprintf("unexpected HTTP status: %s", e.Status)
+	}
+	return fmt.Sprintf("unexpected HTTP status: %s: %s", e.Status, e.Body)
+}
+
+// Is makes errors.Is(err, ErrNotFound) and errors.Is(err, ErrUnauthorized) match the statuses of their kind.
+func (e *HTTPStatusError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized || e.StatusCode == http.StatusForbidden
+	}
+	return false
+}
+
+func newHTTPStatusError(req *http.Request, resp *http.Response, body []byte) *HTTPStatusError {
+	// redacted before being cut, which would make a JSON body unreadable
+	body = RedactBody(body)
+	if len(body) > maxBodySnippetLength {
+		body = body[:maxBodySnippetLength]
+	}
+
+	url := req.URL.String()
+	return &HTTPStatusError{
+		StatusCode: resp.StatusCode,
+		Status:     resp.Status,
+		Method:     req.Method,
+		Action:     getAction(url),
+		Url:        RedactUrl(url),
+		Body:       strings.TrimSpace(string(body)),
+	}
+}
+
+// checkResponseStatus returns an HTTPStatusError for the non-2xx statuses, retryable for the ones that report
+// a transient server condition, or a RateLimitError for 429. The 4xx responses holding an error of Casdoor are
+// left to be decoded into an APIError.
+func checkResponseStatus(req *http.Request, resp *http.Response, body []byte) error {
+	if resp.StatusCode == http.StatusTooManyRequests {
+		rateLimit, _ := parseRateLimit(resp.Header, time.Now())
+		rateLimit.Action = getAction(req.URL.String())
+		return &retryableError{
+			err:        &RateLimitError{RateLimit: rateLimit},
+			retryAfter: rateLimit.RetryAfter,
+		}
+	}
+	if resp.StatusCode >= http.StatusInternalServerError {
+		return &retryableError{
+			err:        newHTTPStatusError(req, resp, body),
+			retryAfter: parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()),
+		}
+	}
+	if resp.StatusCode >= http.StatusMultipleChoices || resp.StatusCode < http.StatusOK {
+		if isErrorResponse(body) {
+			return nil
+		}
+		return newHTTPStatusError(req, resp, body)
+	}
+	return nil
+}
+
+// isErrorResponse reports whether body is a Response of the server with the "error" status.
+func isErrorResponse(body []byte) bool {
+	var response struct {
+		Status string `json:"status"`
+	}
+	return json.Unmarshal(body, &response) == nil && response.Status == "error"
+}
@@ -0,0 +1,86 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"os"
+	"syscall"
+	"testing"
+)
+
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "i/o timeout" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return true }
+
+func newUrlError(err error) error {
+	return &url.Error{Op: "Get", URL: "https://door.casdoor.com/api/get-user", Err: err}
+}
+
+func TestClassifyTransportError(t *testing.T) {
+	tests := []struct {
+		name      string
+		err       error
+		retryable bool
+	}{
+		{"unsupported scheme", newUrlError(errors.New(`unsupported protocol scheme "ftp"`)), false},
+		{"unknown authority", newUrlError(x509.UnknownAuthorityError{}), false},
+		{"invalid certificate", newUrlError(x509.CertificateInvalidError{Reason: x509.Expired}), false},
+		{"wrong hostname", newUrlError(x509.HostnameError{Host: "casdoor.com"}), false},
+		{"unknown error", newUrlError(errors.New("malformed HTTP response")), false},
+		{"timeout", newUrlError(timeoutError{}), true},
+		{"dial", newUrlError(&net.OpError{Op: "dial", Net: "tcp", Err: errors.New("no route to host")}), true},
+		{"read", newUrlError(&net.OpError{Op: "read", Net: "tcp", Err: errors.New("broken")}), true},
+		{"write", newUrlError(&net.OpError{Op: "write", Net: "tcp", Err: errors.New("broken")}), false},
+		{"connection refused", newUrlError(&net.OpError{Op: "dial", Err: os.NewSyscallError("connect", syscall.ECONNREFUSED)}), true},
+		{"connection reset", newUrlError(&net.OpError{Op: "write", Err: os.NewSyscallError("write", syscall.ECONNRESET)}), true},
+		{"eof", newUrlError(io.EOF), true},
+		{"unexpected eof", fmt.Errorf("reading body: %w", io.ErrUnexpectedEOF), true},
+		{"canceled", newUrlError(context.Canceled), false},
+		{"deadline exceeded", newUrlError(context.DeadlineExceeded), false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := classifyTransportError(test.err)
+			if IsRetryable(err) != test.retryable {
+				t.Errorf("IsRetryable(%v) = %v, want %v", test.err, !test.retryable, test.retryable)
+			}
+			if !errors.Is(err, test.err) {
+				t.Errorf("the classified error doesn't wrap %v", test.err)
+			}
+		})
+	}
+}
+
+func TestUnsupportedSchemeIsNotRetryable(t *testing.T) {
+	client := NewClient(&AuthConfig{Endpoint: "ftp://localhost", ClientId: "client-id", ClientSecret: "client-secret"})
+
+	_, err := client.GetUser("alice")
+	if err == nil {
+		t.Fatal("got no error")
+	}
+	if IsRetryable(err) {
+		t.Errorf("IsRetryable(%v) = true", err)
+	}
+}
@@ -0,0 +1,193 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+type filterOperator int
+
+const (
+	filterEq filterOperator = iota
+	filterContains
+	filterIn
+	filterBetween
+	filterHasPrefix
+	filterHasSuffix
+)
+
+type filterCondition struct {
+	field    string
+	operator filterOperator
+	values   []string
+	from, to time.Time
+}
+
+// Filter selects the objects returned by the List* functions, see ListOptions.Filter.
+// It is built by chaining conditions, which must all match:
+//
+//	filter := casdoorsdk.NewFilter().Contains("email", "@corp.com").Between("createdTime", from, to)
+//
+// The server filters by a single "field contains value" condition, so the first Contains condition
+// (or else the first HasPrefix or HasSuffix one, or else the first Eq one) is sent to the server,
+// and the others are checked on the returned page.
+// The total count returned along with the page only accounts for the condition sent to the server.
+type Filter struct {
+	conditions []*filterCondition
+}
+
+func NewFilter() *Filter {
+	return &Filter{}
+}
+
+// Eq keeps the objects whose field equals value.
+func (f *Filter) Eq(field string, value string) *Filter {
+	f.conditions = append(f.conditions, &filterCondition{field: field, operator: filterEq, values: []string{value}})
+	return f
+}
+
+// Contains keeps the objects whose field contains value, ignoring case.
+func (f *Filter) Contains(field string, value string) *Filter {
+	f.conditions = append(f.conditions, &filterCondition{field: field, operator: filterContains, values: []string{value}})
+	return f
+}
+
+// In keeps the objects whose field equals one of values.
+func (f *Filter) In(field string, values ...string) *Filter {
+	f.conditions = append(f.conditions, &filterCondition{field: field, operator: filterIn, values: values})
+	return f
+}
+
+// HasPrefix keeps the objects whose field starts with prefix, ignoring case.
+func (f *Filter) HasPrefix(field string, prefix string) *Filter {
+	f.conditions = append(f.conditions, &filterCondition{field: field, operator: filterHasPrefix, values: []string{prefix}})
+	return f
+}
+
+// HasSuffix keeps the objects whose field ends with suffix, ignoring case, like the users of a mail domain:
+//
+//	filter := casdoorsdk.NewFilter().HasSuffix("email", "@corp.com")
+func (f *Filter) HasSuffix(field string, suffix string) *Filter {
+	f.conditions = append(f.conditions, &filterCondition{field: field, operator: filterHasSuffix, values: []string{suffix}})
+	return f
+}
+
+// Between keeps the objects whose time field, like "createdTime", is within [from, to).
+// A zero from or to leaves the range open on that side.
+func (f *Filter) Between(field string, from time.Time, to time.Time) *Filter {
+	f.conditions = append(f.conditions, &filterCondition{field: field, operator: filterBetween, from: from, to: to})
+	return f
+}
+
+// validate checks that the fields of the conditions are fields of the listed objects.
+func (f *Filter) validate(fieldNames map[string]bool) error {
+	for _, c := range f.conditions {
+		if !fieldNames[strings.ToLower(c.field)] {
+			return fmt.Errorf("invalid filter field: %s", c.field)
+		}
+	}
+	return nil
+}
+
+// getServerCondition returns the condition that is sent to the server, or nil if there is none.
+func (f *Filter) getServerCondition() *filterCondition {
+	for _, operator := range []filterOperator{filterContains, filterHasPrefix, filterHasSuffix, filterEq} {
+		for _, c := range f.conditions {
+			if c.operator == operator {
+				return c
+			}
+		}
+	}
+	return nil
+}
+
+// match reports whether object matches all the conditions of the filter.
+func (f *Filter) match(object interface{}) bool {
+	value := reflect.ValueOf(object)
+	if value.Kind() == reflect.Ptr {
+		value = value.Elem()
+	}
+
+	for _, c := range f.conditions {
+		fieldValue, ok := getJsonFieldValue(value, c.field)
+		if !ok || !c.match(fieldValue) {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *filterCondition) match(value string) bool {
+	switch c.operator {
+	case filterEq:
+		return value == c.values[0]
+	case filterContains:
+		return strings.Contains(strings.ToLower(value), strings.ToLower(c.values[0]))
+	case filterHasPrefix:
+		return strings.HasPrefix(strings.ToLower(value), strings.ToLower(c.values[0]))
+	case filterHasSuffix:
+		return strings.HasSuffix(strings.ToLower(value), strings.ToLower(c.values[0]))
+	case filterIn:
+		for _, v := range c.values {
+			if value == v {
+				return true
+			}
+		}
+		return false
+	case filterBetween:
+		t, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return false
+		}
+		return (c.from.IsZero() || !t.Before(c.from)) && (c.to.IsZero() || t.Before(c.to))
+	}
+	return false
+}
+
+// getJsonFieldValue returns the value of the field of struct value whose JSON name is name, formatted as a string.
+func getJsonFieldValue(value reflect.Value, name string) (string, bool) {
+	t := value.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tagName := strings.Split(field.Tag.Get("json"), ",")[0]
+		if tagName == "" {
+			tagName = field.Name
+		}
+
+		if strings.EqualFold(tagName, name) && field.PkgPath == "" {
+			return fmt.Sprint(value.Field(i).Interface()), true
+		}
+	}
+	return "", false
+}
+
+// filterObjects returns the objects that match filter, which can be nil.
+func filterObjects[T any](filter *Filter, objects []T) []T {
+	if filter == nil {
+		return objects
+	}
+
+	res := objects[:0]
+	for _, object := range objects {
+		if filter.match(object) {
+			res = append(res, object)
+		}
+	}
+	return res
+}
@@ -0,0 +1,101 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package ginmiddleware adapts the middleware package to Gin:
+//
+//	router.Use(ginmiddleware.RequireAuth())
+//
+// and in the handlers:
+//
+//	claims, _ := ginmiddleware.GetClaimsFromGinContext(c)
+package ginmiddleware
+
+import (
+	"github.com/casdoor/casdoor-go-sdk/casdoorsdk"
+	"github.com/casdoor/casdoor-go-sdk/casdoorsdk/middleware"
+	"github.com/gin-gonic/gin"
+)
+
+// ClaimsKey is the key of the claims of the token in the gin.Context.
+const ClaimsKey = "casdoorClaims"
+
+// ErrorHandler writes the response to a request whose token is missing or invalid, and must abort it.
+type ErrorHandler func(c *gin.Context, err error)
+
+// Options configures the middleware returned by New.
+type Options struct {
+	// Client verifies the tokens. The package-level functions of casdoorsdk are used if it is nil.
+	Client *casdoorsdk.Client
+	// ParseOptions are the checks of the claims of the tokens, like their audience.
+	ParseOptions *casdoorsdk.ParseOptions
+	// ErrorHandler writes the response to the rejected requests, like middleware.DefaultErrorHandler by default.
+	ErrorHandler ErrorHandler
+}
+
+// RequireAuth is New(nil), it verifies the tokens with the package-level configuration of casdoorsdk.
+func RequireAuth() gin.HandlerFunc {
+	return New(nil)
+}
+
+// New returns a middleware that verifies the Bearer token of every request with ParseJwtTokenWithOptions,
+// and puts the claims of the valid tokens in the gin.Context and the context of the request.
+func New(options *Options) gin.HandlerFunc {
+	if options == nil {
+		options = &Options{}
+	}
+
+	parse := casdoorsdk.ParseJwtTokenWithOptions
+	if options.Client != nil {
+		parse = options.Client.ParseJwtTokenWithOptions
+	}
+
+	errorHandler := options.ErrorHandler
+	if errorHandler == nil {
+		errorHandler = defaultErrorHandler
+	}
+
+	return func(c *gin.Context) {
+		token, ok := middleware.GetBearerToken(c.Request)
+		if !ok {
+			errorHandler(c, middleware.ErrMissingToken)
+			return
+		}
+
+		claims, err := parse(token, options.ParseOptions)
+		if err != nil {
+			errorHandler(c, err)
+			return
+		}
+
+		c.Set(ClaimsKey, claims)
+		c.Request = c.Request.WithContext(middleware.WithClaims(c.Request.Context(), claims))
+		c.Next()
+	}
+}
+
+func defaultErrorHandler(c *gin.Context, err error) {
+	middleware.DefaultErrorHandler(c.Writer, c.Request, err)
+	c.Abort()
+}
+
+// GetClaimsFromGinContext returns the claims of the token that the middleware verified.
+func GetClaimsFromGinContext(c *gin.Context) (*casdoorsdk.Claims, bool) {
+	value, ok := c.Get(ClaimsKey)
+	if !ok {
+		return nil, false
+	}
+
+	claims, ok := value.(*casdoorsdk.Claims)
+	return claims, ok
+}
@@ -0,0 +1,184 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// Group has the same definition as https://github.com/casdoor/casdoor/blob/master/object/group.go#L25
+type Group struct {
+	Owner       string `xorm:"varchar(100) notnull pk" json:"owner"`
+	Name        string `xorm:"varchar(100) notnull pk unique index" json:"name"`
+	CreatedTime string `xorm:"varchar(100)" json:"createdTime"`
+	UpdatedTime string `xorm:"varchar(100)" json:"updatedTime"`
+
+	DisplayName  string  `xorm:"varchar(100)" json:"displayName"`
+	Manager      string  `xorm:"varchar(100)" json:"manager"`
+	ContactEmail string  `xorm:"varchar(100)" json:"contactEmail"`
+	Type         string  `xorm:"varchar(100)" json:"type"`
+	ParentId     string  `xorm:"varchar(100)" json:"parentId"`
+	IsTopGroup   bool    `xorm:"bool" json:"isTopGroup"`
+	Users        []*User `xorm:"-" json:"users"`
+
+	Title    string   `json:"title,omitempty"`
+	Key      string   `json:"key,omitempty"`
+	Children []*Group `json:"children,omitempty"`
+
+	IsEnabled bool `json:"isEnabled"`
+}
+
+func (c *Client) GetGroups() ([]*Group, error) {
+	return c.GetGroupsWithContext(context.Background())
+}
+
+func (c *Client) GetGroupsWithContext(ctx context.Context) ([]*Group, error) {
+	authConfig := c.getAuthConfig()
+
+	queryMap := map[string]string{
+		"owner": authConfig.OrganizationName,
+	}
+
+	url := c.GetUrl("get-groups", queryMap)
+
+	bytes, err := c.DoGetBytesWithContext(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	var groups []*Group
+	err = c.unmarshal(bytes, &groups)
+	if err != nil {
+		return nil, err
+	}
+	return groups, nil
+}
+
+// GetGroupTree returns the top groups of the organization, with their subgroups nested in Children.
+func (c *Client) GetGroupTree() ([]*Group, error) {
+	return c.GetGroupTreeWithContext(context.Background())
+}
+
+func (c *Client) GetGroupTreeWithContext(ctx context.Context) ([]*Group, error) {
+	authConfig := c.getAuthConfig()
+
+	queryMap := map[string]string{
+		"owner":    authConfig.OrganizationName,
+		"withTree": "true",
+	}
+
+	url := c.GetUrl("get-groups", queryMap)
+
+	bytes, err := c.DoGetBytesWithContext(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	var groups []*Group
+	err = c.unmarshal(bytes, &groups)
+	if err != nil {
+		return nil, err
+	}
+	return groups, nil
+}
+
+func (c *Client) GetPaginationGroups(p int, pageSize int, queryMap map[string]string) ([]*Group, int, error) {
+	return c.GetPaginationGroupsWithContext(context.Background(), p, pageSize, queryMap)
+}
+
+func (c *Client) GetPaginationGroupsWithContext(ctx context.Context, p int, pageSize int, queryMap map[string]string) ([]*Group, int, error) {
+	authConfig := c.getAuthConfig()
+
+	queryMap = getPaginationQueryMap(authConfig.OrganizationName, p, pageSize, queryMap)
+
+	url := c.GetUrl("get-groups", queryMap)
+
+	response, err := c.DoGetResponseWithContext(ctx, url)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	bytes, err := json.Marshal(response.Data)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var groups []*Group
+	err = c.unmarshal(bytes, &groups)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	count, err := getCount(response)
+	if err != nil {
+		return nil, 0, err
+	}
+	return groups, count, nil
+}
+
+func (c *Client) GetGroup(name string) (*Group, error) {
+	return c.GetGroupWithContext(context.Background(), name)
+}
+
+func (c *Client) GetGroupWithContext(ctx context.Context, name string) (*Group, error) {
+	authConfig := c.getAuthConfig()
+
+	queryMap := map[string]string{
+		"id": fmt.Sprintf("%s/%s", authConfig.OrganizationName, name),
+	}
+
+	url := c.GetUrl("get-group", queryMap)
+
+	bytes, err := c.DoGetBytesWithContext(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	var group *Group
+	err = c.unmarshal(bytes, &group)
+	if err != nil {
+		return nil, err
+	}
+	return group, nil
+}
+
+func (c *Client) UpdateGroup(group *Group) (bool, error) {
+	return c.UpdateGroupWithContext(context.Background(), group)
+}
+
+func (c *Client) UpdateGroupWithContext(ctx context.Context, group *Group) (bool, error) {
+	_, affected, err := c.modifyGroup(ctx, "update-group", group, nil)
+	return affected, err
+}
+
+func (c *Client) AddGroup(group *Group) (bool, error) {
+	return c.AddGroupWithContext(context.Background(), group)
+}
+
+func (c *Client) AddGroupWithContext(ctx context.Context, group *Group) (bool, error) {
+	_, affected, err := c.modifyGroup(ctx, "add-group", group, nil)
+	return affected, err
+}
+
+func (c *Client) DeleteGroup(group *Group) (bool, error) {
+	return c.DeleteGroupWithContext(context.Background(), group)
+}
+
+func (c *Client) DeleteGroupWithContext(ctx context.Context, group *Group) (bool, error) {
+	_, affected, err := c.modifyGroup(ctx, "delete-group", group, nil)
+	return affected, err
+}
@@ -0,0 +1,73 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import "context"
+
+func GetGroups() ([]*Group, error) {
+	return globalClient.GetGroups()
+}
+
+func GetGroupsWithContext(ctx context.Context) ([]*Group, error) {
+	return globalClient.GetGroupsWithContext(ctx)
+}
+
+func GetGroupTree() ([]*Group, error) {
+	return globalClient.GetGroupTree()
+}
+
+func GetGroupTreeWithContext(ctx context.Context) ([]*Group, error) {
+	return globalClient.GetGroupTreeWithContext(ctx)
+}
+
+func GetPaginationGroups(p int, pageSize int, queryMap map[string]string) ([]*Group, int, error) {
+	return globalClient.GetPaginationGroups(p, pageSize, queryMap)
+}
+
+func GetPaginationGroupsWithContext(ctx context.Context, p int, pageSize int, queryMap map[string]string) ([]*Group, int, error) {
+	return globalClient.GetPaginationGroupsWithContext(ctx, p, pageSize, queryMap)
+}
+
+func GetGroup(name string) (*Group, error) {
+	return globalClient.GetGroup(name)
+}
+
+func GetGroupWithContext(ctx context.Context, name string) (*Group, error) {
+	return globalClient.GetGroupWithContext(ctx, name)
+}
+
+func UpdateGroup(group *Group) (bool, error) {
+	return globalClient.UpdateGroup(group)
+}
+
+func UpdateGroupWithContext(ctx context.Context, group *Group) (bool, error) {
+	return globalClient.UpdateGroupWithContext(ctx, group)
+}
+
+func AddGroup(group *Group) (bool, error) {
+	return globalClient.AddGroup(group)
+}
+
+func AddGroupWithContext(ctx context.Context, group *Group) (bool, error) {
+	return globalClient.AddGroupWithContext(ctx, group)
+}
+
+func DeleteGroup(group *Group) (bool, error) {
+	return globalClient.DeleteGroup(group)
+}
+
+func DeleteGroupWithContext(ctx context.Context, group *Group) (bool, error) {
+	return globalClient.DeleteGroupWithContext(ctx, group)
+}
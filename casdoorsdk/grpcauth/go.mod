@@ -0,0 +1,23 @@
+module github.com/casdoor/casdoor-go-sdk/casdoorsdk/grpcauth
+
+go 1.18
+
+require (
+	github.com/casdoor/casdoor-go-sdk v0.0.0-00010101000000-000000000000
+	golang.org/x/oauth2 v0.6.0
+	google.golang.org/grpc v1.55.0
+)
+
+require (
+	github.com/golang-jwt/jwt/v4 v4.1.0 // indirect
+	github.com/golang/protobuf v1.5.3 // indirect
+	golang.org/x/net v0.8.0 // indirect
+	golang.org/x/sys v0.6.0 // indirect
+	golang.org/x/text v0.8.0 // indirect
+	google.golang.org/appengine v1.6.7 // indirect
+	google.golang.org/genproto v0.0.0-20230306155012-7f2fa6fef1f4 // indirect
+	google.golang.org/protobuf v1.30.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
+
+replace github.com/casdoor/casdoor-go-sdk => ../..
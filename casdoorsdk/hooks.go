@@ -0,0 +1,76 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"time"
+)
+
+// RequestHook is called with every request sent to the server, including each retry, once it is authenticated.
+// It may modify req, e.g. to add headers or a custom authentication. A returned error fails the request.
+type RequestHook func(req *http.Request) error
+
+// ResponseHook is called with the outcome of every request sent to the server: its response, whose body can be
+// read again, or the error of the transport if there is none, and the time it took.
+type ResponseHook func(req *http.Request, resp *http.Response, err error, duration time.Duration)
+
+// OnRequest adds a hook called before the requests are sent, after the hooks already added.
+// The hooks are called synchronously and must be fast.
+func (c *Client) OnRequest(hook RequestHook) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.requestHooks = append(c.requestHooks[:len(c.requestHooks):len(c.requestHooks)], hook)
+}
+
+// OnResponse adds a hook called once the responses are received, after the hooks already added,
+// e.g. to record the latency of the requests. The hooks are called synchronously and must be fast.
+func (c *Client) OnResponse(hook ResponseHook) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.responseHooks = append(c.responseHooks[:len(c.responseHooks):len(c.responseHooks)], hook)
+}
+
+func (c *Client) getHooks() ([]RequestHook, []ResponseHook) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.requestHooks, c.responseHooks
+}
+
+// callRequestHooks calls the request hooks with req, stopping at the first error.
+func callRequestHooks(hooks []RequestHook, req *http.Request) error {
+	for _, hook := range hooks {
+		err := hook(req)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// callResponseHooks calls the response hooks, giving each of them a fresh reader of body.
+func callResponseHooks(hooks []ResponseHook, req *http.Request, resp *http.Response, body []byte, err error, duration time.Duration) {
+	for _, hook := range hooks {
+		if resp != nil {
+			resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+		}
+		hook(req, resp, err, duration)
+	}
+}
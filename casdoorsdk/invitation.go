@@ -0,0 +1,201 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// ErrInvalidInvitation is wrapped by the error of VerifyInvitation when the server rejects the code.
+var ErrInvalidInvitation = errors.New("casdoor: invalid invitation code")
+
+// Invitation has the same definition as https://github.com/casdoor/casdoor/blob/master/object/invitation.go#L26
+type Invitation struct {
+	Owner       string `xorm:"varchar(100) notnull pk" json:"owner"`
+	Name        string `xorm:"varchar(100) notnull pk" json:"name"`
+	CreatedTime string `xorm:"varchar(100)" json:"createdTime"`
+	UpdatedTime string `xorm:"varchar(100)" json:"updatedTime"`
+	DisplayName string `xorm:"varchar(100)" json:"displayName"`
+
+	Code        string `xorm:"varchar(100) index" json:"code"`
+	IsRegexp    bool   `json:"isRegexp"`
+	Quota       int    `json:"quota"`
+	UsedCount   int    `json:"usedCount"`
+	Application string `xorm:"varchar(100)" json:"application"`
+	Username    string `xorm:"varchar(100)" json:"username"`
+	Email       string `xorm:"varchar(100)" json:"email"`
+	Phone       string `xorm:"varchar(100)" json:"phone"`
+	SignupGroup string `xorm:"varchar(100)" json:"signupGroup"`
+
+	State string `xorm:"varchar(100)" json:"state"`
+}
+
+func (c *Client) GetInvitations() ([]*Invitation, error) {
+	return c.GetInvitationsWithContext(context.Background())
+}
+
+func (c *Client) GetInvitationsWithContext(ctx context.Context) ([]*Invitation, error) {
+	authConfig := c.getAuthConfig()
+
+	queryMap := map[string]string{
+		"owner": authConfig.OrganizationName,
+	}
+
+	url := c.GetUrl("get-invitations", queryMap)
+
+	bytes, err := c.DoGetBytesWithContext(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	var invitations []*Invitation
+	err = c.unmarshal(bytes, &invitations)
+	if err != nil {
+		return nil, err
+	}
+	return invitations, nil
+}
+
+func (c *Client) GetPaginationInvitations(p int, pageSize int, queryMap map[string]string) ([]*Invitation, int, error) {
+	return c.GetPaginationInvitationsWithContext(context.Background(), p, pageSize, queryMap)
+}
+
+func (c *Client) GetPaginationInvitationsWithContext(ctx context.Context, p int, pageSize int, queryMap map[string]string) ([]*Invitation, int, error) {
+	authConfig := c.getAuthConfig()
+
+	queryMap = getPaginationQueryMap(authConfig.OrganizationName, p, pageSize, queryMap)
+
+	url := c.GetUrl("get-invitations", queryMap)
+
+	response, err := c.DoGetResponseWithContext(ctx, url)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	bytes, err := json.Marshal(response.Data)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var invitations []*Invitation
+	err = c.unmarshal(bytes, &invitations)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	count, err := getCount(response)
+	if err != nil {
+		return nil, 0, err
+	}
+	return invitations, count, nil
+}
+
+func (c *Client) GetInvitation(name string) (*Invitation, error) {
+	return c.GetInvitationWithContext(context.Background(), name)
+}
+
+func (c *Client) GetInvitationWithContext(ctx context.Context, name string) (*Invitation, error) {
+	authConfig := c.getAuthConfig()
+
+	queryMap := map[string]string{
+		"id": fmt.Sprintf("%s/%s", authConfig.OrganizationName, name),
+	}
+
+	url := c.GetUrl("get-invitation", queryMap)
+
+	bytes, err := c.DoGetBytesWithContext(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	var invitation *Invitation
+	err = c.unmarshal(bytes, &invitation)
+	if err != nil {
+		return nil, err
+	}
+	return invitation, nil
+}
+
+func (c *Client) UpdateInvitation(invitation *Invitation) (bool, error) {
+	return c.UpdateInvitationWithContext(context.Background(), invitation)
+}
+
+func (c *Client) UpdateInvitationWithContext(ctx context.Context, invitation *Invitation) (bool, error) {
+	_, affected, err := c.modifyInvitation(ctx, "update-invitation", invitation, nil)
+	return affected, err
+}
+
+func (c *Client) AddInvitation(invitation *Invitation) (bool, error) {
+	return c.AddInvitationWithContext(context.Background(), invitation)
+}
+
+func (c *Client) AddInvitationWithContext(ctx context.Context, invitation *Invitation) (bool, error) {
+	_, affected, err := c.modifyInvitation(ctx, "add-invitation", invitation, nil)
+	return affected, err
+}
+
+func (c *Client) DeleteInvitation(invitation *Invitation) (bool, error) {
+	return c.DeleteInvitationWithContext(context.Background(), invitation)
+}
+
+func (c *Client) DeleteInvitationWithContext(ctx context.Context, invitation *Invitation) (bool, error) {
+	_, affected, err := c.modifyInvitation(ctx, "delete-invitation", invitation, nil)
+	return affected, err
+}
+
+// VerifyInvitation asks the server whether code is a valid invitation code for the configured application,
+// checked the way the signup page does: an active invitation of the organization with some quota left must accept it.
+// It returns the invitation, with its code masked by the server, or an error wrapping ErrInvalidInvitation
+// with the message of the server if the code is rejected.
+func (c *Client) VerifyInvitation(code string) (*Invitation, error) {
+	return c.VerifyInvitationWithContext(context.Background(), code)
+}
+
+func (c *Client) VerifyInvitationWithContext(ctx context.Context, code string) (*Invitation, error) {
+	authConfig := c.getAuthConfig()
+
+	if code == "" {
+		return nil, ErrInvalidInvitation
+	}
+
+	queryMap := map[string]string{
+		"code":          code,
+		"applicationId": fmt.Sprintf("admin/%s", authConfig.ApplicationName),
+	}
+
+	url := c.GetUrl("get-invitation-info", queryMap)
+
+	bytes, err := c.DoGetBytesWithContext(ctx, url)
+	if err != nil {
+		var apiErr *APIError
+		if errors.As(err, &apiErr) {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidInvitation, apiErr.Msg)
+		}
+		return nil, err
+	}
+
+	var invitation *Invitation
+	err = c.unmarshal(bytes, &invitation)
+	if err != nil {
+		return nil, err
+	}
+	if invitation == nil {
+		return nil, ErrInvalidInvitation
+	}
+	return invitation, nil
+}
@@ -0,0 +1,73 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import "context"
+
+func GetInvitations() ([]*Invitation, error) {
+	return globalClient.GetInvitations()
+}
+
+func GetInvitationsWithContext(ctx context.Context) ([]*Invitation, error) {
+	return globalClient.GetInvitationsWithContext(ctx)
+}
+
+func GetPaginationInvitations(p int, pageSize int, queryMap map[string]string) ([]*Invitation, int, error) {
+	return globalClient.GetPaginationInvitations(p, pageSize, queryMap)
+}
+
+func GetPaginationInvitationsWithContext(ctx context.Context, p int, pageSize int, queryMap map[string]string) ([]*Invitation, int, error) {
+	return globalClient.GetPaginationInvitationsWithContext(ctx, p, pageSize, queryMap)
+}
+
+func GetInvitation(name string) (*Invitation, error) {
+	return globalClient.GetInvitation(name)
+}
+
+func GetInvitationWithContext(ctx context.Context, name string) (*Invitation, error) {
+	return globalClient.GetInvitationWithContext(ctx, name)
+}
+
+func UpdateInvitation(invitation *Invitation) (bool, error) {
+	return globalClient.UpdateInvitation(invitation)
+}
+
+func UpdateInvitationWithContext(ctx context.Context, invitation *Invitation) (bool, error) {
+	return globalClient.UpdateInvitationWithContext(ctx, invitation)
+}
+
+func AddInvitation(invitation *Invitation) (bool, error) {
+	return globalClient.AddInvitation(invitation)
+}
+
+func AddInvitationWithContext(ctx context.Context, invitation *Invitation) (bool, error) {
+	return globalClient.AddInvitationWithContext(ctx, invitation)
+}
+
+func DeleteInvitation(invitation *Invitation) (bool, error) {
+	return globalClient.DeleteInvitation(invitation)
+}
+
+func DeleteInvitationWithContext(ctx context.Context, invitation *Invitation) (bool, error) {
+	return globalClient.DeleteInvitationWithContext(ctx, invitation)
+}
+
+func VerifyInvitation(code string) (*Invitation, error) {
+	return globalClient.VerifyInvitation(code)
+}
+
+func VerifyInvitationWithContext(ctx context.Context, code string) (*Invitation, error) {
+	return globalClient.VerifyInvitationWithContext(ctx, code)
+}
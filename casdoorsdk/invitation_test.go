@@ -0,0 +1,60 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestVerifyInvitation(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/get-invitation-info" || r.URL.Query().Get("applicationId") != "admin/app-built-in" {
+			http.NotFound(w, r)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("code") != "welcome" {
+			_, _ = w.Write([]byte(`{"status":"error","msg":"Invitation code is invalid"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"status":"ok","data":{"owner":"built-in","name":"welcome","code":"***","state":"Active"}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(&AuthConfig{
+		Endpoint:         server.URL,
+		ClientId:         "client-id",
+		ClientSecret:     "client-secret",
+		OrganizationName: "built-in",
+		ApplicationName:  "app-built-in",
+	})
+
+	invitation, err := client.VerifyInvitation("welcome")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if invitation.Name != "welcome" {
+		t.Errorf("invitation = %s, want welcome", invitation.Name)
+	}
+
+	_, err = client.VerifyInvitation("unknown")
+	if !errors.Is(err, ErrInvalidInvitation) {
+		t.Errorf("err = %v, want ErrInvalidInvitation", err)
+	}
+}
@@ -0,0 +1,204 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import "context"
+
+const defaultIteratorPageSize = 100
+
+// IteratorOptions configures the iterators over paginated lists.
+type IteratorOptions struct {
+	// PageSize is the number of objects fetched per request, 100 by default.
+	PageSize int
+	// Prefetch is the number of next pages fetched concurrently while the current one is iterated, none by default.
+	Prefetch int
+}
+
+type pageResult[T any] struct {
+	objects []T
+	err     error
+}
+
+// Iterator iterates lazily over a paginated list, fetching its pages as they are reached:
+//
+//	it := casdoorsdk.IterateUsers(nil)
+//	for it.Next() {
+//		user := it.Value()
+//	}
+//	if err := it.Err(); err != nil {
+//	}
+type Iterator[T any] struct {
+	fetch    pageFetcher[T]
+	pageSize int
+	prefetch int
+
+	page  []T
+	index int
+	err   error
+	done  bool
+
+	// lastPage is the number of pages, known once the first page is fetched.
+	lastPage int
+	// nextPage is the next page to schedule a fetch for.
+	nextPage int
+	// pending are the results of the scheduled fetches, in page order.
+	pending []chan pageResult[T]
+}
+
+func newIterator[T any](fetch pageFetcher[T], options *IteratorOptions) *Iterator[T] {
+	it := &Iterator[T]{
+		fetch:    fetch,
+		pageSize: defaultIteratorPageSize,
+		index:    -1,
+		nextPage: 1,
+	}
+
+	if options != nil {
+		if options.PageSize > 0 {
+			it.pageSize = options.PageSize
+		}
+		if options.Prefetch > 0 {
+			it.prefetch = options.Prefetch
+		}
+	}
+
+	return it
+}
+
+// Next advances to the next object and reports whether there is one.
+// It returns false at the end of the list or on error, see Err.
+func (it *Iterator[T]) Next() bool {
+	if it.done {
+		return false
+	}
+
+	it.index++
+	if it.index < len(it.page) {
+		return true
+	}
+
+	if it.lastPage != 0 && it.nextPage > it.lastPage && len(it.pending) == 0 {
+		it.done = true
+		return false
+	}
+
+	var objects []T
+	var err error
+	if it.lastPage == 0 {
+		var count int
+		objects, count, err = it.fetch(it.nextPage, it.pageSize)
+		it.nextPage++
+		it.lastPage = (count + it.pageSize - 1) / it.pageSize
+	} else {
+		it.schedule()
+		result := <-it.pending[0]
+		it.pending = it.pending[1:]
+		objects, err = result.objects, result.err
+	}
+
+	if err != nil {
+		it.err = err
+		it.done = true
+		return false
+	}
+
+	it.page = objects
+	it.index = 0
+	if len(objects) == 0 {
+		it.done = true
+		return false
+	}
+
+	it.schedule()
+	return true
+}
+
+// schedule starts fetching the next pages until the number of pending fetches reaches the prefetch limit.
+// Without prefetching, only the next page is fetched when it is needed.
+func (it *Iterator[T]) schedule() {
+	limit := it.prefetch
+	if limit == 0 && len(it.pending) == 0 && it.index >= len(it.page) {
+		limit = 1
+	}
+
+	for len(it.pending) < limit && it.nextPage <= it.lastPage {
+		result := make(chan pageResult[T], 1)
+		go func(p int) {
+			objects, _, err := it.fetch(p, it.pageSize)
+			result <- pageResult[T]{objects: objects, err: err}
+		}(it.nextPage)
+
+		it.pending = append(it.pending, result)
+		it.nextPage++
+	}
+}
+
+// Value returns the current object.
+func (it *Iterator[T]) Value() T {
+	return it.page[it.index]
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *Iterator[T]) Err() error {
+	return it.err
+}
+
+// UserIterator, RoleIterator, PermissionIterator and TokenIterator are the iterators returned by the Iterate* functions.
+type (
+	UserIterator       = Iterator[*User]
+	RoleIterator       = Iterator[*Role]
+	PermissionIterator = Iterator[*Permission]
+	TokenIterator      = Iterator[*Token]
+)
+
+// IterateUsers iterates over the users of the organization, see Iterator.
+func (c *Client) IterateUsers(options *IteratorOptions) *UserIterator {
+	return c.IterateUsersWithContext(context.Background(), options)
+}
+
+// IterateUsersWithContext is like IterateUsers, with ctx used for the requests fetching the pages.
+// The iteration stops with ctx.Err() once ctx is done.
+func (c *Client) IterateUsersWithContext(ctx context.Context, options *IteratorOptions) *UserIterator {
+	return newIterator(getPages(ctx, c.GetPaginationUsersWithContext), options)
+}
+
+// IterateRoles iterates over the roles of the organization, see Iterator.
+func (c *Client) IterateRoles(options *IteratorOptions) *RoleIterator {
+	return c.IterateRolesWithContext(context.Background(), options)
+}
+
+func (c *Client) IterateRolesWithContext(ctx context.Context, options *IteratorOptions) *RoleIterator {
+	return newIterator(getPages(ctx, c.GetPaginationRolesWithContext), options)
+}
+
+// IteratePermissions iterates over the permissions of the organization, see Iterator.
+func (c *Client) IteratePermissions(options *IteratorOptions) *PermissionIterator {
+	return c.IteratePermissionsWithContext(context.Background(), options)
+}
+
+func (c *Client) IteratePermissionsWithContext(ctx context.Context, options *IteratorOptions) *PermissionIterator {
+	return newIterator(getPages(ctx, c.GetPaginationPermissionsWithContext), options)
+}
+
+// IterateTokens iterates over the tokens of the organization, see Iterator.
+func (c *Client) IterateTokens(options *IteratorOptions) *TokenIterator {
+	return c.IterateTokensWithContext(context.Background(), options)
+}
+
+func (c *Client) IterateTokensWithContext(ctx context.Context, options *IteratorOptions) *TokenIterator {
+	return newIterator(func(p int, pageSize int) ([]*Token, int, error) {
+		return c.GetTokensWithContext(ctx, p, pageSize)
+	}, options)
+}
@@ -0,0 +1,49 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import "context"
+
+func IterateUsers(options *IteratorOptions) *UserIterator {
+	return globalClient.IterateUsers(options)
+}
+
+func IterateUsersWithContext(ctx context.Context, options *IteratorOptions) *UserIterator {
+	return globalClient.IterateUsersWithContext(ctx, options)
+}
+
+func IterateRoles(options *IteratorOptions) *RoleIterator {
+	return globalClient.IterateRoles(options)
+}
+
+func IterateRolesWithContext(ctx context.Context, options *IteratorOptions) *RoleIterator {
+	return globalClient.IterateRolesWithContext(ctx, options)
+}
+
+func IteratePermissions(options *IteratorOptions) *PermissionIterator {
+	return globalClient.IteratePermissions(options)
+}
+
+func IteratePermissionsWithContext(ctx context.Context, options *IteratorOptions) *PermissionIterator {
+	return globalClient.IteratePermissionsWithContext(ctx, options)
+}
+
+func IterateTokens(options *IteratorOptions) *TokenIterator {
+	return globalClient.IterateTokens(options)
+}
+
+func IterateTokensWithContext(ctx context.Context, options *IteratorOptions) *TokenIterator {
+	return globalClient.IterateTokensWithContext(ctx, options)
+}
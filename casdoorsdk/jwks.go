@@ -0,0 +1,340 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+	"math/rand"
+	"time"
+)
+
+// Jwk is a JSON Web Key published by the server, see https://datatracker.ietf.org/doc/html/rfc7517
+type Jwk struct {
+	Kty string   `json:"kty"`
+	Kid string   `json:"kid"`
+	Use string   `json:"use"`
+	Alg string   `json:"alg"`
+	N   string   `json:"n"`
+	E   string   `json:"e"`
+	Crv string   `json:"crv"`
+	X   string   `json:"x"`
+	Y   string   `json:"y"`
+	X5c []string `json:"x5c"`
+}
+
+type Jwks struct {
+	Keys []Jwk `json:"keys"`
+}
+
+// verificationKey is a public key to verify the JWT tokens with.
+type verificationKey struct {
+	publicKey interface{}
+	// notAfter is the expiry of the certificate of the key, if the server published it.
+	notAfter time.Time
+}
+
+const (
+	minKeyRefreshDelay = 5 * time.Second
+	keyRefreshJitter   = 0.1
+	// verificationKeysTtl is how long the keys fetched by ParseJwtToken are used before they are fetched again.
+	verificationKeysTtl = time.Hour
+)
+
+// verificationKeysFetchTimeout bounds the fetches of the keys by ParseJwtToken, which has no context.
+var verificationKeysFetchTimeout = 10 * time.Second
+
+// GetJwks gets the JSON Web Key Set that the server signs the JWT tokens with.
+func (c *Client) GetJwks() (*Jwks, error) {
+	return c.GetJwksWithContext(context.Background())
+}
+
+func (c *Client) GetJwksWithContext(ctx context.Context) (*Jwks, error) {
+	return c.fetchJwks(ctx, c.getAuthConfig().Endpoint)
+}
+
+func (c *Client) fetchJwks(ctx context.Context, endpoint string) (*Jwks, error) {
+	bytes, err := c.DoGetBytesRawWithContext(ctx, c.getJwksUrl(endpoint))
+	if err != nil {
+		return nil, err
+	}
+
+	var jwks Jwks
+	err = c.unmarshal(bytes, &jwks)
+	if err != nil {
+		return nil, err
+	}
+	return &jwks, nil
+}
+
+// StartVerificationKeyRefresher starts a goroutine that fetches the JSON Web Key Set of the server every interval
+// and ahead of the expiry of its certificates, so that ParseJwtToken never waits for a key to be fetched.
+// The OpenID Connect discovery document is refreshed along with the keys.
+// The refreshes are jittered and failed refreshes are retried with exponential backoff.
+// Until the first refresh succeeds, tokens are verified with the configured Certificate.
+// A non-positive interval means an hour. Call the returned function to stop the goroutine.
+func (c *Client) StartVerificationKeyRefresher(interval time.Duration) (stop func()) {
+	if interval <= 0 {
+		interval = verificationKeysTtl
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		backoff := minKeyRefreshDelay
+		for {
+			var delay time.Duration
+			notAfter, err := c.refreshVerificationKeys(ctx)
+			if err == nil {
+				err = c.refreshOpenIDConfiguration(ctx)
+			}
+			if err != nil {
+				delay = backoff
+				backoff *= 2
+				if backoff > interval {
+					backoff = interval
+				}
+			} else {
+				delay = getKeyRefreshDelay(interval, notAfter)
+				backoff = minKeyRefreshDelay
+			}
+
+			timer := time.NewTimer(addJitter(delay, keyRefreshJitter))
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return
+			case <-timer.C:
+			}
+		}
+	}()
+
+	return cancel
+}
+
+// refreshVerificationKeys fetches the keys of the server and returns the earliest expiry of their certificates
+// that haven't expired yet, since the expired ones won't be renewed by fetching them again.
+func (c *Client) refreshVerificationKeys(ctx context.Context) (time.Time, error) {
+	endpoint := c.getAuthConfig().Endpoint
+	jwks, err := c.fetchJwks(ctx, endpoint)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	now := time.Now()
+	var notAfter time.Time
+	keys := map[string]*verificationKey{}
+	for _, jwk := range jwks.Keys {
+		key, err := parseJwk(jwk)
+		if err != nil {
+			// keys that can't be used for verification are skipped, not to lose the others
+			continue
+		}
+
+		keys[jwk.Kid] = key
+		if key.notAfter.After(now) && (notAfter.IsZero() || key.notAfter.Before(notAfter)) {
+			notAfter = key.notAfter
+		}
+	}
+
+	c.verificationKeysMutex.Lock()
+	c.verificationKeysEndpoint = endpoint
+	c.verificationKeys = keys
+	c.verificationKeysFetchedAt = time.Now()
+	c.verificationKeysMutex.Unlock()
+
+	return notAfter, nil
+}
+
+// getVerificationKey returns the key with kid, fetching the keys of the server when kid is unknown, e.g. after
+// the certificate was rotated, or when the keys are older than verificationKeysTtl. The fetches are done at most
+// every minKeyRefreshDelay, so that tokens with made-up kids can't make the SDK flood the server.
+func (c *Client) getVerificationKey(kid string) (interface{}, bool) {
+	publicKey, fetchedAt, ok := c.lookupVerificationKey(kid)
+	if ok && time.Since(fetchedAt) < verificationKeysTtl {
+		return publicKey, true
+	}
+
+	c.verificationKeysFetchMutex.Lock()
+	defer c.verificationKeysFetchMutex.Unlock()
+
+	// the keys may have been fetched while waiting for the mutex
+	publicKey, fetchedAt, ok = c.lookupVerificationKey(kid)
+	if ok && time.Since(fetchedAt) < verificationKeysTtl {
+		return publicKey, true
+	}
+	endpoint := c.getAuthConfig().Endpoint
+	if c.verificationKeysAttemptEndpoint == endpoint && time.Since(c.verificationKeysAttemptAt) < minKeyRefreshDelay {
+		return publicKey, ok
+	}
+
+	c.verificationKeysAttemptEndpoint = endpoint
+	c.verificationKeysAttemptAt = time.Now()
+	ctx, cancel := context.WithTimeout(context.Background(), verificationKeysFetchTimeout)
+	defer cancel()
+	_, err := c.refreshVerificationKeys(ctx)
+	if err != nil {
+		// a stale key is still better than none
+		return publicKey, ok
+	}
+
+	publicKey, _, ok = c.lookupVerificationKey(kid)
+	return publicKey, ok
+}
+
+// lookupVerificationKey returns the cached key with kid, if it was fetched from the configured server.
+func (c *Client) lookupVerificationKey(kid string) (interface{}, time.Time, bool) {
+	endpoint := c.getAuthConfig().Endpoint
+
+	c.verificationKeysMutex.RLock()
+	defer c.verificationKeysMutex.RUnlock()
+
+	if c.verificationKeysEndpoint != endpoint {
+		return nil, time.Time{}, false
+	}
+
+	key, ok := c.verificationKeys[kid]
+	if !ok {
+		return nil, c.verificationKeysFetchedAt, false
+	}
+	return key.publicKey, c.verificationKeysFetchedAt, true
+}
+
+// resetVerificationKeys forgets the keys, so that the keys of another server are fetched after the configuration
+// changes.
+func (c *Client) resetVerificationKeys() {
+	c.verificationKeysMutex.Lock()
+	c.verificationKeysEndpoint = ""
+	c.verificationKeys = nil
+	c.verificationKeysFetchedAt = time.Time{}
+	c.verificationKeysMutex.Unlock()
+}
+
+func parseJwk(jwk Jwk) (*verificationKey, error) {
+	if len(jwk.X5c) != 0 {
+		der, err := base64.StdEncoding.DecodeString(jwk.X5c[0])
+		if err != nil {
+			return nil, err
+		}
+
+		cert, err := x509.ParseCertificate(der)
+		if err != nil {
+			return nil, err
+		}
+
+		return &verificationKey{publicKey: cert.PublicKey, notAfter: cert.NotAfter}, nil
+	}
+
+	switch jwk.Kty {
+	case "RSA":
+		return parseRsaJwk(jwk)
+	case "EC":
+		return parseEcJwk(jwk)
+	case "OKP":
+		if jwk.Crv != "Ed25519" {
+			return nil, fmt.Errorf("unsupported curve: %s", jwk.Crv)
+		}
+
+		x, err := base64.RawURLEncoding.DecodeString(jwk.X)
+		if err != nil {
+			return nil, err
+		}
+		if len(x) != ed25519.PublicKeySize {
+			return nil, fmt.Errorf("invalid Ed25519 key size: %d", len(x))
+		}
+		return &verificationKey{publicKey: ed25519.PublicKey(x)}, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type: %s", jwk.Kty)
+	}
+}
+
+func parseRsaJwk(jwk Jwk) (*verificationKey, error) {
+	n, err := base64.RawURLEncoding.DecodeString(jwk.N)
+	if err != nil {
+		return nil, err
+	}
+
+	e, err := base64.RawURLEncoding.DecodeString(jwk.E)
+	if err != nil {
+		return nil, err
+	}
+
+	publicKey := &rsa.PublicKey{
+		N: new(big.Int).SetBytes(n),
+		E: int(new(big.Int).SetBytes(e).Int64()),
+	}
+	return &verificationKey{publicKey: publicKey}, nil
+}
+
+func parseEcJwk(jwk Jwk) (*verificationKey, error) {
+	var curve elliptic.Curve
+	switch jwk.Crv {
+	case "P-256":
+		curve = elliptic.P256()
+	case "P-384":
+		curve = elliptic.P384()
+	case "P-521":
+		curve = elliptic.P521()
+	default:
+		return nil, fmt.Errorf("unsupported curve: %s", jwk.Crv)
+	}
+
+	x, err := base64.RawURLEncoding.DecodeString(jwk.X)
+	if err != nil {
+		return nil, err
+	}
+
+	y, err := base64.RawURLEncoding.DecodeString(jwk.Y)
+	if err != nil {
+		return nil, err
+	}
+
+	publicKey := &ecdsa.PublicKey{
+		Curve: curve,
+		X:     new(big.Int).SetBytes(x),
+		Y:     new(big.Int).SetBytes(y),
+	}
+	if !curve.IsOnCurve(publicKey.X, publicKey.Y) {
+		return nil, fmt.Errorf("invalid EC key: the point isn't on the curve %s", jwk.Crv)
+	}
+	return &verificationKey{publicKey: publicKey}, nil
+}
+
+// getKeyRefreshDelay returns interval, or less when a certificate expires before the keys would be refreshed again.
+func getKeyRefreshDelay(interval time.Duration, notAfter time.Time) time.Duration {
+	if notAfter.IsZero() {
+		return interval
+	}
+
+	delay := time.Until(notAfter) / 2
+	if delay < minKeyRefreshDelay {
+		return minKeyRefreshDelay
+	}
+	if delay > interval {
+		return interval
+	}
+	return delay
+}
+
+// addJitter randomly spreads d by up to ±ratio, so that many processes don't refresh at the same time.
+func addJitter(d time.Duration, ratio float64) time.Duration {
+	return d + time.Duration((rand.Float64()*2-1)*ratio*float64(d))
+}
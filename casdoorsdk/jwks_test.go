@@ -0,0 +1,265 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+// testKey is a signing key and its self-signed certificate.
+type testKey struct {
+	key         *rsa.PrivateKey
+	certificate []byte
+}
+
+func newTestKey(t *testing.T, notAfter time.Time) *testKey {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "casdoor"},
+		NotBefore:    time.Now().Add(-2 * time.Hour),
+		NotAfter:     notAfter,
+	}
+	certificate, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &testKey{key: key, certificate: certificate}
+}
+
+func (k *testKey) notAfter(t *testing.T) time.Time {
+	t.Helper()
+
+	certificate, err := x509.ParseCertificate(k.certificate)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return certificate.NotAfter
+}
+
+func (k *testKey) pem() string {
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: k.certificate}))
+}
+
+func (k *testKey) jwk(kid string) Jwk {
+	return Jwk{Kty: "RSA", Kid: kid, Use: "sig", Alg: "RS256", X5c: []string{base64.StdEncoding.EncodeToString(k.certificate)}}
+}
+
+func (k *testKey) sign(t *testing.T, kid string) string {
+	t.Helper()
+
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+		"owner": "built-in",
+		"name":  "alice",
+		"exp":   time.Now().Add(time.Hour).Unix(),
+	})
+	token.Header["kid"] = kid
+	signed, err := token.SignedString(k.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signed
+}
+
+// newJwksServer returns a server publishing jwks, and the number of requests of the keys it received.
+func newJwksServer(t *testing.T, handler func(w http.ResponseWriter, r *http.Request), jwks ...Jwk) (*httptest.Server, *int32) {
+	t.Helper()
+
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/.well-known/jwks" {
+			http.NotFound(w, r)
+			return
+		}
+		atomic.AddInt32(&requests, 1)
+		if handler != nil {
+			handler(w, r)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(Jwks{Keys: jwks})
+	}))
+	t.Cleanup(server.Close)
+	return server, &requests
+}
+
+func TestVerificationKeysAreResetWithTheConfig(t *testing.T) {
+	keyA := newTestKey(t, time.Now().Add(time.Hour))
+	keyB := newTestKey(t, time.Now().Add(time.Hour))
+	serverA, _ := newJwksServer(t, nil, keyA.jwk("cert"))
+	serverB, _ := newJwksServer(t, nil, keyB.jwk("cert"))
+
+	client := NewClient(&AuthConfig{Endpoint: serverA.URL})
+	_, err := client.ParseJwtToken(keyA.sign(t, "cert"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client.setConfig(&AuthConfig{Endpoint: serverB.URL})
+	_, err = client.ParseJwtToken(keyA.sign(t, "cert"))
+	if err == nil {
+		t.Error("a token of the previous server is still accepted")
+	}
+	_, err = client.ParseJwtToken(keyB.sign(t, "cert"))
+	if err != nil {
+		t.Errorf("a token of the new server is rejected: %v", err)
+	}
+}
+
+func TestParseJwtTokenTriesTheCertificateFirst(t *testing.T) {
+	key := newTestKey(t, time.Now().Add(time.Hour))
+	otherKey := newTestKey(t, time.Now().Add(time.Hour))
+	blocked := make(chan struct{})
+	defer close(blocked)
+	server, requests := newJwksServer(t, func(w http.ResponseWriter, r *http.Request) {
+		<-blocked
+	})
+
+	client := NewClient(&AuthConfig{Endpoint: server.URL, Certificate: key.pem()})
+	_, err := client.ParseJwtToken(key.sign(t, "cert"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := atomic.LoadInt32(requests); n != 0 {
+		t.Errorf("the keys were fetched %d times for a token signed with the certificate", n)
+	}
+
+	// the keys of the hung server are waited for only until the timeout
+	defer func(timeout time.Duration) { verificationKeysFetchTimeout = timeout }(verificationKeysFetchTimeout)
+	verificationKeysFetchTimeout = 100 * time.Millisecond
+	start := time.Now()
+	_, err = client.ParseJwtToken(otherKey.sign(t, "other"))
+	if err == nil {
+		t.Error("a token signed with an unknown key is accepted")
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("the keys were waited for %s", elapsed)
+	}
+}
+
+func TestExpiredCertificatesDontHurryTheRefresh(t *testing.T) {
+	expiredKey := newTestKey(t, time.Now().Add(-time.Hour))
+	key := newTestKey(t, time.Now().Add(4*time.Hour))
+	server, _ := newJwksServer(t, nil, expiredKey.jwk("expired"), key.jwk("cert"))
+
+	client := NewClient(&AuthConfig{Endpoint: server.URL})
+	notAfter, err := client.refreshVerificationKeys(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !notAfter.Equal(key.notAfter(t)) {
+		t.Errorf("got expiry %s, want the one of the valid certificate %s", notAfter, key.notAfter(t))
+	}
+	if delay := getKeyRefreshDelay(time.Hour, notAfter); delay != time.Hour {
+		t.Errorf("got refresh delay %s, want 1h", delay)
+	}
+}
+
+func TestGetKeyRefreshDelay(t *testing.T) {
+	tests := []struct {
+		name     string
+		notAfter time.Time
+		want     time.Duration
+	}{
+		{"no expiry", time.Time{}, time.Hour},
+		{"far expiry", time.Now().Add(24 * time.Hour), time.Hour},
+		{"near expiry", time.Now().Add(time.Hour), 30 * time.Minute},
+		{"imminent expiry", time.Now().Add(time.Second), minKeyRefreshDelay},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			delay := getKeyRefreshDelay(time.Hour, test.notAfter)
+			if delay < test.want-time.Second || delay > test.want {
+				t.Errorf("got %s, want %s", delay, test.want)
+			}
+		})
+	}
+}
+
+func TestVerificationKeyRefresherDefaultsTheInterval(t *testing.T) {
+	key := newTestKey(t, time.Now().Add(time.Hour))
+	server, requests := newJwksServer(t, nil, key.jwk("cert"))
+
+	client := NewClient(&AuthConfig{Endpoint: server.URL})
+	stop := client.StartVerificationKeyRefresher(0)
+	time.Sleep(200 * time.Millisecond)
+	stop()
+
+	if n := atomic.LoadInt32(requests); n != 1 {
+		t.Errorf("the keys were fetched %d times, want once", n)
+	}
+}
+
+func TestVerificationKeysAreRefreshedForANewKid(t *testing.T) {
+	oldKey := newTestKey(t, time.Now().Add(time.Hour))
+	newKey := newTestKey(t, time.Now().Add(time.Hour))
+	var rotated int32
+	server, requests := newJwksServer(t, func(w http.ResponseWriter, r *http.Request) {
+		jwks := Jwks{Keys: []Jwk{oldKey.jwk("old")}}
+		if atomic.LoadInt32(&rotated) != 0 {
+			jwks.Keys = append(jwks.Keys, newKey.jwk("new"))
+		}
+		_ = json.NewEncoder(w).Encode(jwks)
+	})
+
+	client := NewClient(&AuthConfig{Endpoint: server.URL})
+	_, err := client.ParseJwtToken(oldKey.sign(t, "old"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// a made-up kid doesn't make the keys be fetched again right away
+	atomic.StoreInt32(&rotated, 1)
+	_, err = client.ParseJwtToken(newKey.sign(t, "new"))
+	if err == nil {
+		t.Error("a token with an unknown kid is accepted")
+	}
+	if n := atomic.LoadInt32(requests); n != 1 {
+		t.Errorf("the keys were fetched %d times, want 1", n)
+	}
+
+	client.verificationKeysAttemptAt = time.Now().Add(-minKeyRefreshDelay)
+	_, err = client.ParseJwtToken(newKey.sign(t, "new"))
+	if err != nil {
+		t.Errorf("a token signed with the rotated key is rejected: %v", err)
+	}
+	_, err = client.ParseJwtToken(oldKey.sign(t, "old"))
+	if err != nil {
+		t.Errorf("a token signed with the previous key is rejected: %v", err)
+	}
+	if n := atomic.LoadInt32(requests); n != 2 {
+		t.Errorf("the keys were fetched %d times, want 2", n)
+	}
+}
@@ -15,7 +15,15 @@
 package casdoorsdk
 
 import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
 	"fmt"
+	"sync"
+	"time"
 
 	"github.com/golang-jwt/jwt/v4"
 )
@@ -23,28 +31,224 @@ import (
 type Claims struct {
 	User
 	AccessToken string `json:"accessToken"`
+	// Nonce is the nonce of the authorization request that the ID token was issued for, if any.
+	Nonce string `json:"nonce,omitempty"`
 	jwt.RegisteredClaims
 }
 
-func ParseJwtToken(token string) (*Claims, error) {
-	t, err := jwt.ParseWithClaims(token, &Claims{}, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodRSA); !ok {
-			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+// registeredClaims holds the fields of Claims that don't belong to the embedded User.
+type registeredClaims struct {
+	AccessToken string `json:"accessToken"`
+	Nonce       string `json:"nonce,omitempty"`
+	jwt.RegisteredClaims
+}
+
+// UnmarshalJSON overrides the method promoted from the embedded User, which would only decode the user fields.
+// Claims are never decoded strictly, since the token carries fields that belong to neither.
+func (c *Claims) UnmarshalJSON(data []byte) error {
+	type user User
+	var claims registeredClaims
+	unknownFields, err := unmarshalWithUnknownFields(data, (*user)(&c.User), &claims)
+	if err != nil {
+		return err
+	}
+
+	c.User.Extra = unknownFields
+	c.AccessToken = claims.AccessToken
+	c.Nonce = claims.Nonce
+	c.RegisteredClaims = claims.RegisteredClaims
+	return nil
+}
+
+// MarshalJSON overrides the method promoted from the embedded User, which would only encode the user fields.
+func (c Claims) MarshalJSON() ([]byte, error) {
+	type user User
+	claims := registeredClaims{
+		AccessToken:      c.AccessToken,
+		Nonce:            c.Nonce,
+		RegisteredClaims: c.RegisteredClaims,
+	}
+	return marshalWithUnknownFields(c.User.Extra, user(c.User), claims)
+}
+
+// ParseOptions are the checks of ParseJwtTokenWithOptions, in addition to the signature and the time claims.
+type ParseOptions struct {
+	// Audience, if set, must be one of the audiences of the token, i.e. the client id of the application.
+	Audience string
+	// Issuer, if set, must be the issuer of the token, i.e. the origin of the server.
+	Issuer string
+	// Nonce, if set, must be the nonce of the ID token, as sent with the authorization request.
+	Nonce string
+	// Leeway is the clock skew tolerated when checking the exp, nbf and iat claims.
+	Leeway time.Duration
+}
+
+// ParseJwtToken verifies token and returns its claims. The token is verified with the configured Certificate,
+// or else the cert of the application once it has been fetched in the background, or, if it isn't signed with it,
+// with the key of the JSON Web Key Set of the server matching its kid, fetched and cached as needed.
+func (c *Client) ParseJwtToken(token string) (*Claims, error) {
+	return c.ParseJwtTokenWithOptions(token, nil)
+}
+
+// ParseJwtTokenWithOptions is like ParseJwtToken, and also checks the claims of the token against options.
+// A token failing a check is reported by a *jwt.ValidationError telling which claim is invalid.
+func (c *Client) ParseJwtTokenWithOptions(token string, options *ParseOptions) (*Claims, error) {
+	authConfig := c.getAuthConfig()
+
+	certificate := authConfig.Certificate
+	if certificate == "" {
+		if cert, ok := c.peekApplicationCert(); ok {
+			certificate = cert.Certificate
 		}
+	}
 
-		publicKey, err := jwt.ParseRSAPublicKeyFromPEM([]byte(authConfig.Certificate))
-		if err != nil {
-			return nil, err
+	t, err := c.verifyJwtToken(token, certificate)
+	if err != nil {
+		return nil, err
+	}
+
+	claims, ok := t.Claims.(*Claims)
+	if !ok || !t.Valid {
+		return nil, jwt.NewValidationError("token is invalid", jwt.ValidationErrorMalformed)
+	}
+
+	if options == nil {
+		options = &ParseOptions{}
+	}
+	err = validateClaims(claims, options, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+// validateClaims checks the time claims of a token, with the leeway of options, and the other claims set in options.
+func validateClaims(claims *Claims, options *ParseOptions, now time.Time) error {
+	if !claims.VerifyExpiresAt(now.Add(-options.Leeway), false) {
+		return jwt.NewValidationError(fmt.Sprintf("token has expired at %s", claims.ExpiresAt.Time), jwt.ValidationErrorExpired)
+	}
+	if !claims.VerifyNotBefore(now.Add(options.Leeway), false) {
+		return jwt.NewValidationError(fmt.Sprintf("token isn't valid before %s", claims.NotBefore.Time), jwt.ValidationErrorNotValidYet)
+	}
+	if !claims.VerifyIssuedAt(now.Add(options.Leeway), false) {
+		return jwt.NewValidationError(fmt.Sprintf("token is issued in the future at %s", claims.IssuedAt.Time), jwt.ValidationErrorIssuedAt)
+	}
+
+	if options.Audience != "" && !claims.VerifyAudience(options.Audience, true) {
+		return jwt.NewValidationError(fmt.Sprintf("unexpected audience: %v, expected: %s", claims.Audience, options.Audience), jwt.ValidationErrorAudience)
+	}
+	if options.Issuer != "" && claims.Issuer != options.Issuer {
+		return jwt.NewValidationError(fmt.Sprintf("unexpected issuer: %s, expected: %s", claims.Issuer, options.Issuer), jwt.ValidationErrorIssuer)
+	}
+	if options.Nonce != "" && !secureCompare(claims.Nonce, options.Nonce) {
+		return jwt.NewValidationError("unexpected nonce", jwt.ValidationErrorClaimsInvalid)
+	}
+	return nil
+}
+
+// verifyJwtToken parses token and verifies its signature with the key of certificate, or, if the token isn't
+// signed with it, with the key of the server with the kid of the token. The certificate is tried first, so that
+// parsing the tokens it signed never waits for the keys of the server.
+func (c *Client) verifyJwtToken(token string, certificate string) (*jwt.Token, error) {
+	parser := jwt.Parser{SkipClaimsValidation: true}
+
+	if certificate != "" {
+		t, err := parser.ParseWithClaims(token, &Claims{}, func(token *jwt.Token) (interface{}, error) {
+			publicKey, err := getCertificatePublicKey(certificate)
+			if err != nil {
+				return nil, err
+			}
+			return checkSigningMethod(token, publicKey)
+		})
+		if err == nil || !isSignatureError(err) || t == nil {
+			return t, err
 		}
+		if _, hasKid := t.Header["kid"].(string); !hasKid {
+			return t, err
+		}
+	}
 
-		return publicKey, nil
+	return parser.ParseWithClaims(token, &Claims{}, func(token *jwt.Token) (interface{}, error) {
+		kid, ok := token.Header["kid"].(string)
+		if !ok {
+			return nil, fmt.Errorf("the token has no kid and no certificate is configured")
+		}
+
+		publicKey, ok := c.getVerificationKey(kid)
+		if !ok {
+			return nil, fmt.Errorf("no verification key with kid: %s", kid)
+		}
+		return checkSigningMethod(token, publicKey)
 	})
+}
+
+// isSignatureError reports whether err means that a token couldn't be verified with the key it was given.
+func isSignatureError(err error) bool {
+	var validationErr *jwt.ValidationError
+	return errors.As(err, &validationErr) &&
+		validationErr.Errors&(jwt.ValidationErrorSignatureInvalid|jwt.ValidationErrorUnverifiable) != 0
+}
+
+func checkSigningMethod(token *jwt.Token, publicKey interface{}) (interface{}, error) {
+	if !isSigningMethodOfKey(token.Method, publicKey) {
+		return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+	}
+	return publicKey, nil
+}
+
+// isSigningMethodOfKey reports whether method is an algorithm of the type of publicKey, so that e.g. a token
+// claiming to be signed with HS256 isn't verified with the public key as the HMAC secret.
+func isSigningMethodOfKey(method jwt.SigningMethod, publicKey interface{}) bool {
+	switch publicKey.(type) {
+	case *rsa.PublicKey:
+		switch method.(type) {
+		case *jwt.SigningMethodRSA, *jwt.SigningMethodRSAPSS:
+			return true
+		}
+	case *ecdsa.PublicKey:
+		_, ok := method.(*jwt.SigningMethodECDSA)
+		return ok
+	case ed25519.PublicKey:
+		_, ok := method.(*jwt.SigningMethodEd25519)
+		return ok
+	}
+	return false
+}
+
+// certificateKey caches the public key of the last parsed certificate, since parsing it
+// on every ParseJwtToken call is much more expensive than verifying the token itself.
+var certificateKey struct {
+	sync.Mutex
+	certificate string
+	publicKey   interface{}
+}
 
-	if t != nil {
-		if claims, ok := t.Claims.(*Claims); ok && t.Valid {
-			return claims, nil
+// getCertificatePublicKey returns the RSA, ECDSA or Ed25519 public key of a PEM certificate or public key.
+func getCertificatePublicKey(certificate string) (interface{}, error) {
+	certificateKey.Lock()
+	defer certificateKey.Unlock()
+
+	if certificateKey.publicKey != nil && certificateKey.certificate == certificate {
+		return certificateKey.publicKey, nil
+	}
+
+	block, _ := pem.Decode([]byte(certificate))
+	if block == nil {
+		return nil, jwt.ErrKeyMustBePEMEncoded
+	}
+
+	var publicKey interface{}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err == nil {
+		publicKey = cert.PublicKey
+	} else {
+		publicKey, err = x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, err
 		}
 	}
 
-	return nil, err
+	certificateKey.certificate = certificate
+	certificateKey.publicKey = publicKey
+	return publicKey, nil
 }
@@ -0,0 +1,115 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Limiter throttles the requests sent to the server: Wait blocks until a request may be sent, or ctx is done.
+// It is satisfied by *rate.Limiter of golang.org/x/time/rate.
+type Limiter interface {
+	Wait(ctx context.Context) error
+}
+
+// SetLimiter makes the client wait for limiter before sending each request, including each retry,
+// e.g. to avoid hammering the server during bulk operations. nil removes it.
+func (c *Client) SetLimiter(limiter Limiter) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.limiter = limiter
+}
+
+func (c *Client) getLimiter() Limiter {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.limiter
+}
+
+// tokenBucket is a Limiter allowing rate requests per second on average, and bursts of burst requests.
+type tokenBucket struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// NewTokenBucketLimiter returns a Limiter allowing requestsPerSecond requests per second on average,
+// and bursts of up to burst requests, at least 1. A requestsPerSecond of 0 or less doesn't limit the requests.
+func NewTokenBucketLimiter(requestsPerSecond float64, burst int) Limiter {
+	if burst < 1 {
+		burst = 1
+	}
+
+	return &tokenBucket{
+		rate:   requestsPerSecond,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+func (b *tokenBucket) Wait(ctx context.Context) error {
+	delay := b.reserve(time.Now())
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		b.cancel()
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// reserve takes a token, and returns how long to wait until it is actually available.
+func (b *tokenBucket) reserve(now time.Time) time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.rate <= 0 {
+		return 0
+	}
+
+	b.tokens += now.Sub(b.last).Seconds() * b.rate
+	if b.tokens > b.burst {
+		b.tokens = b.burst
+	}
+	b.last = now
+
+	b.tokens--
+	if b.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
+
+// cancel gives back a token reserved by a request which gave up waiting for it.
+func (b *tokenBucket) cancel() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.tokens++
+}
@@ -0,0 +1,326 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+const maxPageSize = 1000
+
+// ListOptions are the pagination, filtering and sorting parameters of the List* functions.
+// They are validated before sending the request, to catch mistakes that the server would report confusingly.
+type ListOptions struct {
+	// Page is the page to return, counted from 1.
+	Page int
+	// PageSize is the number of objects per page, from 1 to 1000.
+	PageSize int
+
+	// Field and Value keep the objects whose field contains value.
+	Field string
+	Value string
+	// Filter selects the objects with more conditions than Field and Value, which must be empty when it is set.
+	Filter *Filter
+
+	// SortField is the field to sort the objects by, and SortOrder is "ascend" (default) or "descend".
+	SortField string
+	SortOrder string
+}
+
+// defaultListOptions are used when nil options are passed.
+var defaultListOptions = ListOptions{
+	Page:     1,
+	PageSize: defaultIteratorPageSize,
+}
+
+// getQueryMap validates the options for listing objects like object and returns them as query parameters,
+// except for the pagination ones that the GetPagination* functions set.
+func (o *ListOptions) getQueryMap(object interface{}) (map[string]string, error) {
+	if o.Page < 1 {
+		return nil, fmt.Errorf("invalid page: %d, pages are counted from 1", o.Page)
+	}
+	if o.PageSize < 1 || o.PageSize > maxPageSize {
+		return nil, fmt.Errorf("invalid page size: %d, it must be from 1 to %d", o.PageSize, maxPageSize)
+	}
+
+	fieldNames := getJsonFieldNames(reflect.TypeOf(object).Elem())
+	if o.Field != "" && !fieldNames[strings.ToLower(o.Field)] {
+		return nil, fmt.Errorf("invalid field: %s", o.Field)
+	}
+	if o.Filter != nil {
+		if o.Field != "" {
+			return nil, fmt.Errorf("field and filter can't be both set")
+		}
+
+		err := o.Filter.validate(fieldNames)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if o.SortField != "" && !fieldNames[strings.ToLower(o.SortField)] {
+		return nil, fmt.Errorf("invalid sort field: %s", o.SortField)
+	}
+	if o.SortOrder != "" && o.SortOrder != "ascend" && o.SortOrder != "descend" {
+		return nil, fmt.Errorf("invalid sort order: %s, it must be \"ascend\" or \"descend\"", o.SortOrder)
+	}
+
+	queryMap := map[string]string{}
+	if o.Field != "" {
+		queryMap["field"] = o.Field
+		queryMap["value"] = o.Value
+	}
+	if o.Filter != nil {
+		if c := o.Filter.getServerCondition(); c != nil {
+			queryMap["field"] = c.field
+			queryMap["value"] = c.values[0]
+		}
+	}
+	if o.SortField != "" {
+		queryMap["sortField"] = o.SortField
+		queryMap["sortOrder"] = o.SortOrder
+		if o.SortOrder == "" {
+			queryMap["sortOrder"] = "ascend"
+		}
+	}
+	return queryMap, nil
+}
+
+func getListOptions(options *ListOptions) *ListOptions {
+	if options == nil {
+		return &defaultListOptions
+	}
+	return options
+}
+
+// ListUsers returns a page of the users of the organization and the total count of users, see ListOptions.
+// For example, the users whose email ends with "@corp.com", the most recent first:
+//
+//	users, count, err := client.ListUsers(&casdoorsdk.ListOptions{
+//		Page:      1,
+//		PageSize:  100,
+//		Filter:    casdoorsdk.NewFilter().HasSuffix("email", "@corp.com"),
+//		SortField: "createdTime",
+//		SortOrder: "descend",
+//	})
+func (c *Client) ListUsers(options *ListOptions) ([]*User, int, error) {
+	return c.ListUsersWithContext(context.Background(), options)
+}
+
+func (c *Client) ListUsersWithContext(ctx context.Context, options *ListOptions) ([]*User, int, error) {
+	return listObjects(ctx, options, c.GetPaginationUsersWithContext)
+}
+
+// ListRoles returns a page of the roles of the organization and the total count of roles, see ListOptions.
+func (c *Client) ListRoles(options *ListOptions) ([]*Role, int, error) {
+	return c.ListRolesWithContext(context.Background(), options)
+}
+
+func (c *Client) ListRolesWithContext(ctx context.Context, options *ListOptions) ([]*Role, int, error) {
+	return listObjects(ctx, options, c.GetPaginationRolesWithContext)
+}
+
+// ListPermissions returns a page of the permissions of the organization and the total count of permissions, see ListOptions.
+func (c *Client) ListPermissions(options *ListOptions) ([]*Permission, int, error) {
+	return c.ListPermissionsWithContext(context.Background(), options)
+}
+
+func (c *Client) ListPermissionsWithContext(ctx context.Context, options *ListOptions) ([]*Permission, int, error) {
+	return listObjects(ctx, options, c.GetPaginationPermissionsWithContext)
+}
+
+// ListTokens returns a page of the tokens of the organization and the total count of tokens, see ListOptions.
+func (c *Client) ListTokens(options *ListOptions) ([]*Token, int, error) {
+	return c.ListTokensWithContext(context.Background(), options)
+}
+
+func (c *Client) ListTokensWithContext(ctx context.Context, options *ListOptions) ([]*Token, int, error) {
+	return listObjects(ctx, options, c.GetPaginationTokensWithContext)
+}
+
+// ListRecords returns a page of the records of the organization and the total count of records, see ListOptions.
+// The records of a user, an action or a time range are selected with a Filter, like:
+//
+//	filter := casdoorsdk.NewFilter().Eq("user", "alice").Eq("action", "login").Between("createdTime", from, to)
+func (c *Client) ListRecords(options *ListOptions) ([]*Record, int, error) {
+	return c.ListRecordsWithContext(context.Background(), options)
+}
+
+func (c *Client) ListRecordsWithContext(ctx context.Context, options *ListOptions) ([]*Record, int, error) {
+	return listObjects(ctx, options, c.GetPaginationRecordsWithContext)
+}
+
+// ListGroups returns a page of the groups of the organization and the total count of groups, see ListOptions.
+func (c *Client) ListGroups(options *ListOptions) ([]*Group, int, error) {
+	return c.ListGroupsWithContext(context.Background(), options)
+}
+
+func (c *Client) ListGroupsWithContext(ctx context.Context, options *ListOptions) ([]*Group, int, error) {
+	return listObjects(ctx, options, c.GetPaginationGroupsWithContext)
+}
+
+// ListAdapters returns a page of the adapters of the organization and the total count of adapters, see ListOptions.
+func (c *Client) ListAdapters(options *ListOptions) ([]*Adapter, int, error) {
+	return c.ListAdaptersWithContext(context.Background(), options)
+}
+
+func (c *Client) ListAdaptersWithContext(ctx context.Context, options *ListOptions) ([]*Adapter, int, error) {
+	return listObjects(ctx, options, c.GetPaginationAdaptersWithContext)
+}
+
+// ListEnforcers returns a page of the enforcers of the organization and the total count of enforcers, see ListOptions.
+func (c *Client) ListEnforcers(options *ListOptions) ([]*Enforcer, int, error) {
+	return c.ListEnforcersWithContext(context.Background(), options)
+}
+
+func (c *Client) ListEnforcersWithContext(ctx context.Context, options *ListOptions) ([]*Enforcer, int, error) {
+	return listObjects(ctx, options, c.GetPaginationEnforcersWithContext)
+}
+
+// ListModels returns a page of the models of the organization and the total count of models, see ListOptions.
+func (c *Client) ListModels(options *ListOptions) ([]*Model, int, error) {
+	return c.ListModelsWithContext(context.Background(), options)
+}
+
+func (c *Client) ListModelsWithContext(ctx context.Context, options *ListOptions) ([]*Model, int, error) {
+	return listObjects(ctx, options, c.GetPaginationModelsWithContext)
+}
+
+// ListInvitations returns a page of the invitations of the organization and the total count of invitations, see ListOptions.
+func (c *Client) ListInvitations(options *ListOptions) ([]*Invitation, int, error) {
+	return c.ListInvitationsWithContext(context.Background(), options)
+}
+
+func (c *Client) ListInvitationsWithContext(ctx context.Context, options *ListOptions) ([]*Invitation, int, error) {
+	return listObjects(ctx, options, c.GetPaginationInvitationsWithContext)
+}
+
+// ListResources returns a page of the resources of the organization and the total count of resources, see ListOptions.
+func (c *Client) ListResources(options *ListOptions) ([]*Resource, int, error) {
+	return c.ListResourcesWithContext(context.Background(), options)
+}
+
+func (c *Client) ListResourcesWithContext(ctx context.Context, options *ListOptions) ([]*Resource, int, error) {
+	return listObjects(ctx, options, c.GetPaginationResourcesWithContext)
+}
+
+// ListProducts returns a page of the products of the organization and the total count of products, see ListOptions.
+func (c *Client) ListProducts(options *ListOptions) ([]*Product, int, error) {
+	return c.ListProductsWithContext(context.Background(), options)
+}
+
+func (c *Client) ListProductsWithContext(ctx context.Context, options *ListOptions) ([]*Product, int, error) {
+	return listObjects(ctx, options, c.GetPaginationProductsWithContext)
+}
+
+// ListPayments returns a page of the payments of the organization and the total count of payments, see ListOptions.
+func (c *Client) ListPayments(options *ListOptions) ([]*Payment, int, error) {
+	return c.ListPaymentsWithContext(context.Background(), options)
+}
+
+func (c *Client) ListPaymentsWithContext(ctx context.Context, options *ListOptions) ([]*Payment, int, error) {
+	return listObjects(ctx, options, c.GetPaginationPaymentsWithContext)
+}
+
+// ListPlans returns a page of the plans of the organization and the total count of plans, see ListOptions.
+func (c *Client) ListPlans(options *ListOptions) ([]*Plan, int, error) {
+	return c.ListPlansWithContext(context.Background(), options)
+}
+
+func (c *Client) ListPlansWithContext(ctx context.Context, options *ListOptions) ([]*Plan, int, error) {
+	return listObjects(ctx, options, c.GetPaginationPlansWithContext)
+}
+
+// ListPricings returns a page of the pricings of the organization and the total count of pricings, see ListOptions.
+func (c *Client) ListPricings(options *ListOptions) ([]*Pricing, int, error) {
+	return c.ListPricingsWithContext(context.Background(), options)
+}
+
+func (c *Client) ListPricingsWithContext(ctx context.Context, options *ListOptions) ([]*Pricing, int, error) {
+	return listObjects(ctx, options, c.GetPaginationPricingsWithContext)
+}
+
+// ListSubscriptions returns a page of the subscriptions of the organization and the total count of subscriptions, see ListOptions.
+func (c *Client) ListSubscriptions(options *ListOptions) ([]*Subscription, int, error) {
+	return c.ListSubscriptionsWithContext(context.Background(), options)
+}
+
+func (c *Client) ListSubscriptionsWithContext(ctx context.Context, options *ListOptions) ([]*Subscription, int, error) {
+	return listObjects(ctx, options, c.GetPaginationSubscriptionsWithContext)
+}
+
+// ListProviders returns a page of the providers and the total count of providers, see ListOptions.
+func (c *Client) ListProviders(options *ListOptions) ([]*Provider, int, error) {
+	return c.ListProvidersWithContext(context.Background(), options)
+}
+
+func (c *Client) ListProvidersWithContext(ctx context.Context, options *ListOptions) ([]*Provider, int, error) {
+	return listObjects(ctx, options, c.GetPaginationProvidersWithContext)
+}
+
+// ListCerts returns a page of the certs and the total count of certs, see ListOptions.
+func (c *Client) ListCerts(options *ListOptions) ([]*Cert, int, error) {
+	return c.ListCertsWithContext(context.Background(), options)
+}
+
+func (c *Client) ListCertsWithContext(ctx context.Context, options *ListOptions) ([]*Cert, int, error) {
+	return listObjects(ctx, options, c.GetPaginationCertsWithContext)
+}
+
+// ListApplications returns a page of the applications and the total count of applications, see ListOptions.
+func (c *Client) ListApplications(options *ListOptions) ([]*Application, int, error) {
+	return c.ListApplicationsWithContext(context.Background(), options)
+}
+
+func (c *Client) ListApplicationsWithContext(ctx context.Context, options *ListOptions) ([]*Application, int, error) {
+	return listObjects(ctx, options, c.GetPaginationApplicationsWithContext)
+}
+
+// ListWebhooks returns a page of the webhooks of the organization and the total count of webhooks, see ListOptions.
+func (c *Client) ListWebhooks(options *ListOptions) ([]*Webhook, int, error) {
+	return c.ListWebhooksWithContext(context.Background(), options)
+}
+
+func (c *Client) ListWebhooksWithContext(ctx context.Context, options *ListOptions) ([]*Webhook, int, error) {
+	return listObjects(ctx, options, c.GetPaginationWebhooksWithContext)
+}
+
+// ListSyncers returns a page of the syncers of the organization and the total count of syncers, see ListOptions.
+func (c *Client) ListSyncers(options *ListOptions) ([]*Syncer, int, error) {
+	return c.ListSyncersWithContext(context.Background(), options)
+}
+
+func (c *Client) ListSyncersWithContext(ctx context.Context, options *ListOptions) ([]*Syncer, int, error) {
+	return listObjects(ctx, options, c.GetPaginationSyncersWithContext)
+}
+
+// listObjects returns the page of objects selected by options, fetched with getPagination, and their total count.
+func listObjects[T any](ctx context.Context, options *ListOptions, getPagination func(ctx context.Context, p int, pageSize int, queryMap map[string]string) ([]T, int, error)) ([]T, int, error) {
+	options = getListOptions(options)
+
+	var object T
+	queryMap, err := options.getQueryMap(object)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	objects, count, err := getPagination(ctx, options.Page, options.PageSize, queryMap)
+	if err != nil {
+		return nil, 0, err
+	}
+	return filterObjects(options.Filter, objects), count, nil
+}
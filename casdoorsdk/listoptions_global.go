@@ -0,0 +1,185 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import "context"
+
+func ListUsers(options *ListOptions) ([]*User, int, error) {
+	return globalClient.ListUsers(options)
+}
+
+func ListUsersWithContext(ctx context.Context, options *ListOptions) ([]*User, int, error) {
+	return globalClient.ListUsersWithContext(ctx, options)
+}
+
+func ListRoles(options *ListOptions) ([]*Role, int, error) {
+	return globalClient.ListRoles(options)
+}
+
+func ListRolesWithContext(ctx context.Context, options *ListOptions) ([]*Role, int, error) {
+	return globalClient.ListRolesWithContext(ctx, options)
+}
+
+func ListPermissions(options *ListOptions) ([]*Permission, int, error) {
+	return globalClient.ListPermissions(options)
+}
+
+func ListPermissionsWithContext(ctx context.Context, options *ListOptions) ([]*Permission, int, error) {
+	return globalClient.ListPermissionsWithContext(ctx, options)
+}
+
+func ListTokens(options *ListOptions) ([]*Token, int, error) {
+	return globalClient.ListTokens(options)
+}
+
+func ListTokensWithContext(ctx context.Context, options *ListOptions) ([]*Token, int, error) {
+	return globalClient.ListTokensWithContext(ctx, options)
+}
+
+func ListRecords(options *ListOptions) ([]*Record, int, error) {
+	return globalClient.ListRecords(options)
+}
+
+func ListRecordsWithContext(ctx context.Context, options *ListOptions) ([]*Record, int, error) {
+	return globalClient.ListRecordsWithContext(ctx, options)
+}
+
+func ListGroups(options *ListOptions) ([]*Group, int, error) {
+	return globalClient.ListGroups(options)
+}
+
+func ListGroupsWithContext(ctx context.Context, options *ListOptions) ([]*Group, int, error) {
+	return globalClient.ListGroupsWithContext(ctx, options)
+}
+
+func ListAdapters(options *ListOptions) ([]*Adapter, int, error) {
+	return globalClient.ListAdapters(options)
+}
+
+func ListAdaptersWithContext(ctx context.Context, options *ListOptions) ([]*Adapter, int, error) {
+	return globalClient.ListAdaptersWithContext(ctx, options)
+}
+
+func ListEnforcers(options *ListOptions) ([]*Enforcer, int, error) {
+	return globalClient.ListEnforcers(options)
+}
+
+func ListEnforcersWithContext(ctx context.Context, options *ListOptions) ([]*Enforcer, int, error) {
+	return globalClient.ListEnforcersWithContext(ctx, options)
+}
+
+func ListModels(options *ListOptions) ([]*Model, int, error) {
+	return globalClient.ListModels(options)
+}
+
+func ListModelsWithContext(ctx context.Context, options *ListOptions) ([]*Model, int, error) {
+	return globalClient.ListModelsWithContext(ctx, options)
+}
+
+func ListInvitations(options *ListOptions) ([]*Invitation, int, error) {
+	return globalClient.ListInvitations(options)
+}
+
+func ListInvitationsWithContext(ctx context.Context, options *ListOptions) ([]*Invitation, int, error) {
+	return globalClient.ListInvitationsWithContext(ctx, options)
+}
+
+func ListResources(options *ListOptions) ([]*Resource, int, error) {
+	return globalClient.ListResources(options)
+}
+
+func ListResourcesWithContext(ctx context.Context, options *ListOptions) ([]*Resource, int, error) {
+	return globalClient.ListResourcesWithContext(ctx, options)
+}
+
+func ListProducts(options *ListOptions) ([]*Product, int, error) {
+	return globalClient.ListProducts(options)
+}
+
+func ListProductsWithContext(ctx context.Context, options *ListOptions) ([]*Product, int, error) {
+	return globalClient.ListProductsWithContext(ctx, options)
+}
+
+func ListPayments(options *ListOptions) ([]*Payment, int, error) {
+	return globalClient.ListPayments(options)
+}
+
+func ListPaymentsWithContext(ctx context.Context, options *ListOptions) ([]*Payment, int, error) {
+	return globalClient.ListPaymentsWithContext(ctx, options)
+}
+
+func ListPlans(options *ListOptions) ([]*Plan, int, error) {
+	return globalClient.ListPlans(options)
+}
+
+func ListPlansWithContext(ctx context.Context, options *ListOptions) ([]*Plan, int, error) {
+	return globalClient.ListPlansWithContext(ctx, options)
+}
+
+func ListPricings(options *ListOptions) ([]*Pricing, int, error) {
+	return globalClient.ListPricings(options)
+}
+
+func ListPricingsWithContext(ctx context.Context, options *ListOptions) ([]*Pricing, int, error) {
+	return globalClient.ListPricingsWithContext(ctx, options)
+}
+
+func ListSubscriptions(options *ListOptions) ([]*Subscription, int, error) {
+	return globalClient.ListSubscriptions(options)
+}
+
+func ListSubscriptionsWithContext(ctx context.Context, options *ListOptions) ([]*Subscription, int, error) {
+	return globalClient.ListSubscriptionsWithContext(ctx, options)
+}
+
+func ListProviders(options *ListOptions) ([]*Provider, int, error) {
+	return globalClient.ListProviders(options)
+}
+
+func ListProvidersWithContext(ctx context.Context, options *ListOptions) ([]*Provider, int, error) {
+	return globalClient.ListProvidersWithContext(ctx, options)
+}
+
+func ListCerts(options *ListOptions) ([]*Cert, int, error) {
+	return globalClient.ListCerts(options)
+}
+
+func ListCertsWithContext(ctx context.Context, options *ListOptions) ([]*Cert, int, error) {
+	return globalClient.ListCertsWithContext(ctx, options)
+}
+
+func ListApplications(options *ListOptions) ([]*Application, int, error) {
+	return globalClient.ListApplications(options)
+}
+
+func ListApplicationsWithContext(ctx context.Context, options *ListOptions) ([]*Application, int, error) {
+	return globalClient.ListApplicationsWithContext(ctx, options)
+}
+
+func ListWebhooks(options *ListOptions) ([]*Webhook, int, error) {
+	return globalClient.ListWebhooks(options)
+}
+
+func ListWebhooksWithContext(ctx context.Context, options *ListOptions) ([]*Webhook, int, error) {
+	return globalClient.ListWebhooksWithContext(ctx, options)
+}
+
+func ListSyncers(options *ListOptions) ([]*Syncer, int, error) {
+	return globalClient.ListSyncers(options)
+}
+
+func ListSyncersWithContext(ctx context.Context, options *ListOptions) ([]*Syncer, int, error) {
+	return globalClient.ListSyncersWithContext(ctx, options)
+}
@@ -0,0 +1,65 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import (
+	"net/http"
+	"time"
+)
+
+// Logger is the logger the SDK logs its requests to, at debug level, with alternating keys and values.
+// It is satisfied by *slog.Logger, and easily adapted from the other structured loggers.
+type Logger interface {
+	Debug(msg string, keysAndValues ...interface{})
+}
+
+// SetLogger makes the SDK log every request it sends, including each retry, to logger: its action, method, url,
+// HTTP status, duration and error if any. The secrets in the urls are redacted. nil disables it.
+func (c *Client) SetLogger(logger Logger) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.logger = logger
+}
+
+func (c *Client) getLogger() Logger {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.logger
+}
+
+// logRequest logs the outcome of req to logger, if it isn't nil.
+func logRequest(logger Logger, req *http.Request, resp *http.Response, err error, duration time.Duration) {
+	if logger == nil {
+		return
+	}
+
+	url := req.URL.String()
+	keysAndValues := []interface{}{
+		"action", getAction(url),
+		"method", req.Method,
+		"url", RedactUrl(url),
+		"duration", duration,
+	}
+	if resp != nil {
+		keysAndValues = append(keysAndValues, "status", resp.StatusCode)
+	}
+	if err != nil {
+		keysAndValues = append(keysAndValues, "error", err)
+	}
+
+	logger.Debug("casdoor request", keysAndValues...)
+}
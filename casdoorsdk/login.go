@@ -0,0 +1,353 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+// The signin methods of LoginForm.
+const (
+	SigninMethodPassword         = "Password"
+	SigninMethodVerificationCode = "Verification code"
+)
+
+// The next steps of LoginResult, returned instead of a token when the user has to do more to sign in.
+const (
+	// LoginNextStepMfa asks for the passcode of a multi-factor authentication method of the user, in MfaProps.
+	LoginNextStepMfa = "NextMfa"
+	// LoginNextStepMfaSetup asks the user to set up multi-factor authentication first, see GetMfaSetupUrl.
+	LoginNextStepMfaSetup = "RequiredMfa"
+	// LoginNextStepChangePassword asks the user to change their password first.
+	LoginNextStepChangePassword = "NextChangePasswordForm"
+)
+
+// LoginForm is what the user entered to sign in to the configured application.
+type LoginForm struct {
+	// SigninMethod is SigninMethodPassword or SigninMethodVerificationCode.
+	SigninMethod string `json:"signinMethod,omitempty"`
+	Organization string `json:"organization,omitempty"`
+	// Username is the name, email address or phone number of the user.
+	Username string `json:"username,omitempty"`
+	Password string `json:"password,omitempty"`
+	// Code is the verification code sent with SendVerificationCode, or the code of the identity provider.
+	Code     string `json:"code,omitempty"`
+	Provider string `json:"provider,omitempty"`
+
+	// MfaType and Passcode (or RecoveryCode) answer LoginNextStepMfa.
+	MfaType      string `json:"mfaType,omitempty"`
+	Passcode     string `json:"passcode,omitempty"`
+	RecoveryCode string `json:"recoveryCode,omitempty"`
+
+	AutoSignin bool     `json:"autoSignin,omitempty"`
+	Captcha    *Captcha `json:"-"`
+
+	// RedirectUri must be registered in the application, though nothing is redirected to it.
+	RedirectUri string `json:"redirectUri,omitempty"`
+}
+
+// LoginResult is the result of a login: either the token of the user, or the next step to sign in.
+type LoginResult struct {
+	Token *oauth2.Token
+	// NextStep is one of the LoginNextStep constants, or empty if the user is signed in.
+	NextStep string
+	// MfaProps are the multi-factor authentication methods the user can answer LoginNextStepMfa with.
+	MfaProps []*MfaProps
+}
+
+// authForm is the body of the login API, see https://github.com/casdoor/casdoor/blob/master/form/auth.go
+type authForm struct {
+	*LoginForm
+
+	Type        string `json:"type"`
+	Application string `json:"application"`
+	State       string `json:"state,omitempty"`
+	Method      string `json:"method,omitempty"`
+
+	CaptchaType  string `json:"captchaType,omitempty"`
+	CaptchaToken string `json:"captchaToken,omitempty"`
+	ClientSecret string `json:"clientSecret,omitempty"`
+}
+
+// Captcha is the result of a captcha solved by the user, to pass to the server when the application requires one.
+type Captcha struct {
+	// Type is the type of the captcha provider, like "reCAPTCHA", "hCaptcha" or "Cloudflare Turnstile".
+	Type string
+	// Token is the response token returned by the captcha widget.
+	Token string
+	// ClientSecret is the id of the captcha for the "Default" captcha of Casdoor, and is empty otherwise.
+	ClientSecret string
+}
+
+// setCaptcha adds captcha to the form, if any.
+func (form *authForm) setCaptcha(captcha *Captcha) {
+	if captcha == nil {
+		return
+	}
+
+	form.CaptchaType = captcha.Type
+	form.CaptchaToken = captcha.Token
+	form.ClientSecret = captcha.ClientSecret
+}
+
+// Login signs in with form through the login API of the server, so that products can have their own sign-in screens.
+// The server asks for an authorization code for the configured application, which is exchanged for the token of the user.
+// When the user has to do more to sign in, the result has a NextStep instead, e.g. LoginNextStepMfa is answered by
+// calling Login again with the same form and the MfaType and Passcode of the user. The server keeps track of the
+// first step in a session cookie, so the http client must keep cookies, see SetHttpClient.
+// A rejected captcha is reported as an *APIError with ErrorCodeCaptchaFailed.
+func (c *Client) Login(form LoginForm) (*LoginResult, error) {
+	return c.LoginWithContext(context.Background(), form)
+}
+
+func (c *Client) LoginWithContext(ctx context.Context, form LoginForm) (*LoginResult, error) {
+	authConfig := c.getAuthConfig()
+
+	body := &authForm{
+		LoginForm:   &form,
+		Type:        "code",
+		Application: authConfig.ApplicationName,
+		State:       authConfig.ApplicationName,
+	}
+	if form.Provider != "" {
+		body.Method = "signup"
+	}
+	body.setCaptcha(form.Captcha)
+
+	postBytes, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.DoPostWithContext(ctx, "login", c.getLoginQueryMap(form.RedirectUri), postBytes, false, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.getLoginResult(ctx, resp)
+}
+
+// getLoginQueryMap returns the parameters of the authorization code the login API is asked for.
+func (c *Client) getLoginQueryMap(redirectUri string) map[string]string {
+	authConfig := c.getAuthConfig()
+
+	return map[string]string{
+		"clientId":     authConfig.ClientId,
+		"responseType": "code",
+		"redirectUri":  redirectUri,
+		"scope":        "read",
+		"state":        authConfig.ApplicationName,
+	}
+}
+
+// getLoginResult exchanges the authorization code returned by a login for a token, or returns the next step.
+func (c *Client) getLoginResult(ctx context.Context, resp *Response) (*LoginResult, error) {
+	data, _ := resp.Data.(string)
+	switch data {
+	case "":
+		return nil, fmt.Errorf("casdoor: login didn't return an authorization code")
+	case LoginNextStepMfa, LoginNextStepMfaSetup, LoginNextStepChangePassword:
+		result := &LoginResult{NextStep: data}
+		if resp.Data2 != nil {
+			bytes, err := json.Marshal(resp.Data2)
+			if err != nil {
+				return nil, err
+			}
+			// data2 is only a list of methods for LoginNextStepMfa
+			_ = json.Unmarshal(bytes, &result.MfaProps)
+		}
+		return result, nil
+	}
+
+	token, err := c.GetOAuthTokenWithContext(ctx, data, c.getAuthConfig().ApplicationName)
+	if err != nil {
+		return nil, err
+	}
+	return &LoginResult{Token: token}, nil
+}
+
+// BeginWebAuthnLogin starts to sign in the user of the organization with WebAuthn, and returns the credential
+// request options to pass to navigator.credentials.get() in the browser of the user.
+// The server keeps the challenge in a session cookie, so the http client must keep cookies, see SetHttpClient.
+func (c *Client) BeginWebAuthnLogin(organization string, username string) (json.RawMessage, error) {
+	return c.BeginWebAuthnLoginWithContext(context.Background(), organization, username)
+}
+
+func (c *Client) BeginWebAuthnLoginWithContext(ctx context.Context, organization string, username string) (json.RawMessage, error) {
+	queryMap := map[string]string{
+		"owner": organization,
+		"name":  username,
+	}
+
+	url := c.GetUrl("webauthn/signin/begin", queryMap)
+
+	bytes, err := c.DoGetBytesRawWithContext(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+	return bytes, nil
+}
+
+// FinishWebAuthnLogin signs in with credential, the JSON encoded assertion returned by navigator.credentials.get()
+// for the options of BeginWebAuthnLogin. redirectUri must be registered in the application.
+func (c *Client) FinishWebAuthnLogin(credential []byte, redirectUri string) (*LoginResult, error) {
+	return c.FinishWebAuthnLoginWithContext(context.Background(), credential, redirectUri)
+}
+
+func (c *Client) FinishWebAuthnLoginWithContext(ctx context.Context, credential []byte, redirectUri string) (*LoginResult, error) {
+	resp, err := c.DoPostWithContext(ctx, "webauthn/signin/finish", c.getLoginQueryMap(redirectUri), credential, false, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.getLoginResult(ctx, resp)
+}
+
+// googleIdTokenPrefix marks a Google ID token passed as the code of a Google provider, instead of an authorization code.
+const googleIdTokenPrefix = "GoogleIdToken-"
+
+// LoginWithProvider signs in with the credential issued to the user by the identity provider providerName
+// configured in the application, signing the user up if needed.
+// For most providers, code is the authorization code returned to the redirect uri of the provider, which the server
+// exchanges itself, so that a native app can sign in with the provider SDK and hand the code to the backend.
+// redirectUri must be registered in the application.
+func (c *Client) LoginWithProvider(providerName string, code string, redirectUri string) (*LoginResult, error) {
+	return c.LoginWithProviderWithContext(context.Background(), providerName, code, redirectUri)
+}
+
+func (c *Client) LoginWithProviderWithContext(ctx context.Context, providerName string, code string, redirectUri string) (*LoginResult, error) {
+	return c.LoginWithContext(ctx, LoginForm{
+		Provider:    providerName,
+		Code:        code,
+		RedirectUri: redirectUri,
+	})
+}
+
+// LoginWithGoogleIdToken is like LoginWithProvider for a Google provider, but with a Google ID token
+// (e.g. from Google Sign-In on Android or iOS) instead of an authorization code.
+func (c *Client) LoginWithGoogleIdToken(providerName string, idToken string, redirectUri string) (*LoginResult, error) {
+	return c.LoginWithGoogleIdTokenWithContext(context.Background(), providerName, idToken, redirectUri)
+}
+
+func (c *Client) LoginWithGoogleIdTokenWithContext(ctx context.Context, providerName string, idToken string, redirectUri string) (*LoginResult, error) {
+	return c.LoginWithProviderWithContext(ctx, providerName, googleIdTokenPrefix+idToken, redirectUri)
+}
+
+// QrCodeLogin is a QR code login started by StartQrCodeLogin.
+type QrCodeLogin struct {
+	client *Client
+
+	Provider string
+	// Image is the QR code to show to the user, as a base64 encoded PNG.
+	Image  string
+	Ticket string
+}
+
+// StartQrCodeLogin creates a QR code login session with the QR code login provider providerName
+// (a WeChat official account provider), for the user to scan with their mobile app.
+func (c *Client) StartQrCodeLogin(providerName string) (*QrCodeLogin, error) {
+	return c.StartQrCodeLoginWithContext(context.Background(), providerName)
+}
+
+func (c *Client) StartQrCodeLoginWithContext(ctx context.Context, providerName string) (*QrCodeLogin, error) {
+	queryMap := map[string]string{
+		"id": fmt.Sprintf("admin/%s", providerName),
+	}
+
+	url := c.GetUrl("get-qrcode", queryMap)
+
+	resp, err := c.DoGetResponseWithContext(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	image, _ := resp.Data.(string)
+	ticket, _ := resp.Data2.(string)
+	if ticket == "" {
+		return nil, fmt.Errorf("casdoor: get-qrcode didn't return a ticket")
+	}
+
+	return &QrCodeLogin{
+		client:   c,
+		Provider: providerName,
+		Image:    image,
+		Ticket:   ticket,
+	}, nil
+}
+
+// IsConfirmed reports whether the user has scanned the QR code and confirmed the login on their mobile app.
+func (q *QrCodeLogin) IsConfirmed() (bool, error) {
+	return q.IsConfirmedWithContext(context.Background())
+}
+
+func (q *QrCodeLogin) IsConfirmedWithContext(ctx context.Context) (bool, error) {
+	queryMap := map[string]string{
+		"ticket": q.Ticket,
+	}
+
+	url := q.client.GetUrl("get-webhook-event", queryMap)
+
+	// the error status is read here instead of being converted into an APIError by DoGetBytesRaw
+	bytes, _, err := q.client.doGet(ctx, url)
+	if err != nil {
+		return false, err
+	}
+
+	var response Response
+	err = json.Unmarshal(bytes, &response)
+	if err != nil {
+		return false, err
+	}
+
+	// The server answers with an error until the ticket has been scanned.
+	return response.Status == "ok", nil
+}
+
+// Wait polls IsConfirmed every interval until the login is confirmed or ctx is done.
+func (q *QrCodeLogin) Wait(ctx context.Context, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		confirmed, err := q.IsConfirmedWithContext(ctx)
+		if err != nil {
+			return err
+		}
+		if confirmed {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Login signs the user in once the login is confirmed.
+// redirectUri must be registered in the application.
+func (q *QrCodeLogin) Login(redirectUri string) (*LoginResult, error) {
+	return q.LoginWithContext(context.Background(), redirectUri)
+}
+
+func (q *QrCodeLogin) LoginWithContext(ctx context.Context, redirectUri string) (*LoginResult, error) {
+	return q.client.LoginWithProviderWithContext(ctx, q.Provider, q.Ticket, redirectUri)
+}
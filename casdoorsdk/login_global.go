@@ -0,0 +1,68 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import (
+	"context"
+	"encoding/json"
+)
+
+func Login(form LoginForm) (*LoginResult, error) {
+	return globalClient.Login(form)
+}
+
+func LoginWithContext(ctx context.Context, form LoginForm) (*LoginResult, error) {
+	return globalClient.LoginWithContext(ctx, form)
+}
+
+func BeginWebAuthnLogin(organization string, username string) (json.RawMessage, error) {
+	return globalClient.BeginWebAuthnLogin(organization, username)
+}
+
+func BeginWebAuthnLoginWithContext(ctx context.Context, organization string, username string) (json.RawMessage, error) {
+	return globalClient.BeginWebAuthnLoginWithContext(ctx, organization, username)
+}
+
+func FinishWebAuthnLogin(credential []byte, redirectUri string) (*LoginResult, error) {
+	return globalClient.FinishWebAuthnLogin(credential, redirectUri)
+}
+
+func FinishWebAuthnLoginWithContext(ctx context.Context, credential []byte, redirectUri string) (*LoginResult, error) {
+	return globalClient.FinishWebAuthnLoginWithContext(ctx, credential, redirectUri)
+}
+
+func LoginWithProvider(providerName string, code string, redirectUri string) (*LoginResult, error) {
+	return globalClient.LoginWithProvider(providerName, code, redirectUri)
+}
+
+func LoginWithProviderWithContext(ctx context.Context, providerName string, code string, redirectUri string) (*LoginResult, error) {
+	return globalClient.LoginWithProviderWithContext(ctx, providerName, code, redirectUri)
+}
+
+func LoginWithGoogleIdToken(providerName string, idToken string, redirectUri string) (*LoginResult, error) {
+	return globalClient.LoginWithGoogleIdToken(providerName, idToken, redirectUri)
+}
+
+func LoginWithGoogleIdTokenWithContext(ctx context.Context, providerName string, idToken string, redirectUri string) (*LoginResult, error) {
+	return globalClient.LoginWithGoogleIdTokenWithContext(ctx, providerName, idToken, redirectUri)
+}
+
+func StartQrCodeLogin(providerName string) (*QrCodeLogin, error) {
+	return globalClient.StartQrCodeLogin(providerName)
+}
+
+func StartQrCodeLoginWithContext(ctx context.Context, providerName string) (*QrCodeLogin, error) {
+	return globalClient.StartQrCodeLoginWithContext(ctx, providerName)
+}
@@ -0,0 +1,85 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import "context"
+
+// Logout ends the Casdoor session of the user signed in with accessToken and expires the token on the server,
+// instead of only discarding the token locally. If revokeTokens is true, the other tokens of the user
+// in the application, e.g. from other devices, are deleted as well.
+func (c *Client) Logout(accessToken string, revokeTokens bool) error {
+	return c.LogoutWithContext(context.Background(), accessToken, revokeTokens)
+}
+
+func (c *Client) LogoutWithContext(ctx context.Context, accessToken string, revokeTokens bool) error {
+	var claims *Claims
+	if revokeTokens {
+		var err error
+		claims, err = c.ParseJwtToken(accessToken)
+		if err != nil {
+			return err
+		}
+	}
+
+	queryMap := map[string]string{
+		"id_token_hint": accessToken,
+	}
+
+	url := c.GetUrl("logout", queryMap)
+
+	_, err := c.DoGetResponseWithContext(ctx, url)
+	if err != nil {
+		return err
+	}
+
+	if !revokeTokens {
+		return nil
+	}
+	_, err = c.deleteUserTokens(ctx, claims.Owner, claims.Name, c.getAuthConfig().ApplicationName)
+	return err
+}
+
+// deleteUserTokens deletes the tokens of the user in application, or in all the applications if it is empty,
+// and returns the names of the deleted tokens.
+func (c *Client) deleteUserTokens(ctx context.Context, organization string, name string, application string) ([]string, error) {
+	filter := NewFilter().Eq("user", name).Eq("organization", organization)
+	if application != "" {
+		filter.Eq("application", application)
+	}
+
+	// the tokens are all listed first, since deleting them shifts the pages
+	var tokens []*Token
+	for page := 1; ; page++ {
+		pageTokens, count, err := c.ListTokensWithContext(ctx, &ListOptions{Page: page, PageSize: maxPageSize, Filter: filter})
+		if err != nil {
+			return nil, err
+		}
+
+		tokens = append(tokens, pageTokens...)
+		if page*maxPageSize >= count {
+			break
+		}
+	}
+
+	var names []string
+	for _, token := range tokens {
+		_, err := c.DeleteTokenWithContext(ctx, token.Name)
+		if err != nil {
+			return names, err
+		}
+		names = append(names, token.Name)
+	}
+	return names, nil
+}
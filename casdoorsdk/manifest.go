@@ -0,0 +1,407 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Manifest describes objects that an organization must contain, to bootstrap Casdoor environments reproducibly.
+// It can be written as Go structs or parsed from YAML or JSON with ParseManifest.
+//
+// Applying a manifest creates the objects that are missing and updates the ones that differ, while the objects
+// that are not in the manifest are left untouched, so that applying the same manifest again changes nothing.
+// Only the fields that the manifest sets to non-zero values are compared and updated; the others keep
+// their values on the server, so omitting a field, like the displayName of a role, doesn't reset it.
+// As a consequence, a manifest can't set a field to a zero value, e.g. disable an enabled role.
+// The certs, providers and webhooks are owned by "admin", like the applications, which belong to the organization.
+type Manifest struct {
+	Certs        []*Cert        `json:"certs"`
+	Providers    []*Provider    `json:"providers"`
+	Applications []*Application `json:"applications"`
+	Webhooks     []*Webhook     `json:"webhooks"`
+	Groups       []*Group       `json:"groups"`
+	Roles        []*Role        `json:"roles"`
+	Permissions  []*Permission  `json:"permissions"`
+}
+
+// ParseManifest parses a manifest written in YAML, or JSON, with the same field names as the JSON of the objects.
+func ParseManifest(data []byte) (*Manifest, error) {
+	var document interface{}
+	err := yaml.Unmarshal(data, &document)
+	if err != nil {
+		return nil, err
+	}
+
+	// the objects are decoded from JSON, to honor their json tags and custom decoding
+	jsonData, err := json.Marshal(document)
+	if err != nil {
+		return nil, err
+	}
+
+	var manifest Manifest
+	err = json.Unmarshal(jsonData, &manifest)
+	if err != nil {
+		return nil, err
+	}
+	return &manifest, nil
+}
+
+type ChangeType string
+
+const (
+	ChangeTypeCreate ChangeType = "create"
+	ChangeTypeUpdate ChangeType = "update"
+)
+
+// Change is a change needed to make the server match a manifest.
+type Change struct {
+	Type ChangeType
+	Kind string
+	Name string
+
+	apply func() error
+}
+
+func (c *Change) String() string {
+	return fmt.Sprintf("%s %s %s", c.Type, c.Kind, c.Name)
+}
+
+// manifestIgnoredFields are the fields that are set by the server and never compared.
+var manifestIgnoredFields = []string{"owner", "createdTime", "updatedTime"}
+
+// PlanManifest compares manifest with the objects of the organization and returns the changes needed to apply it,
+// in the order they must be applied, without changing anything.
+func (c *Client) PlanManifest(manifest *Manifest) ([]*Change, error) {
+	return c.PlanManifestWithContext(context.Background(), manifest)
+}
+
+func (c *Client) PlanManifestWithContext(ctx context.Context, manifest *Manifest) ([]*Change, error) {
+	authConfig := c.getAuthConfig()
+
+	var changes []*Change
+	var err error
+
+	// the objects are created in the order of their dependencies, e.g. the certs and providers of the applications
+	changes, err = planManifestKind(ctx, changes, manifestKind[Cert]{
+		kind:       "cert",
+		locals:     manifest.Certs,
+		getRemotes: c.GetCertsWithContext,
+		getName:    func(cert *Cert) string { return cert.Name },
+		prepare: func(local *Cert) {
+			if local.Owner == "" {
+				local.Owner = "admin"
+			}
+		},
+		add:    c.AddCertWithContext,
+		update: c.UpdateCertWithContext,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err = planManifestKind(ctx, changes, manifestKind[Provider]{
+		kind:       "provider",
+		locals:     manifest.Providers,
+		getRemotes: c.GetProvidersWithContext,
+		getName:    func(provider *Provider) string { return provider.Name },
+		prepare: func(local *Provider) {
+			if local.Owner == "" {
+				local.Owner = "admin"
+			}
+		},
+		add:    c.AddProviderWithContext,
+		update: c.UpdateProviderWithContext,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err = planManifestKind(ctx, changes, manifestKind[Application]{
+		kind:       "application",
+		locals:     manifest.Applications,
+		getRemotes: c.GetOrganizationApplicationsWithContext,
+		getName:    func(application *Application) string { return application.Name },
+		prepare: func(local *Application) {
+			if local.Owner == "" {
+				local.Owner = "admin"
+			}
+			if local.Organization == "" {
+				local.Organization = authConfig.OrganizationName
+			}
+		},
+		add:    c.AddApplicationWithContext,
+		update: c.UpdateApplicationWithContext,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err = planManifestKind(ctx, changes, manifestKind[Webhook]{
+		kind:       "webhook",
+		locals:     manifest.Webhooks,
+		getRemotes: c.GetWebhooksWithContext,
+		getName:    func(webhook *Webhook) string { return webhook.Name },
+		prepare: func(local *Webhook) {
+			if local.Owner == "" {
+				local.Owner = "admin"
+			}
+			if local.Organization == "" {
+				local.Organization = authConfig.OrganizationName
+			}
+		},
+		add:    c.AddWebhookWithContext,
+		update: c.UpdateWebhookWithContext,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err = planManifestKind(ctx, changes, manifestKind[Group]{
+		kind:       "group",
+		locals:     manifest.Groups,
+		getRemotes: c.GetGroupsWithContext,
+		getName:    func(group *Group) string { return group.Name },
+		prepare: func(local *Group) {
+			if local.Owner == "" {
+				local.Owner = authConfig.OrganizationName
+			}
+		},
+		add:    c.AddGroupWithContext,
+		update: c.UpdateGroupWithContext,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err = planManifestKind(ctx, changes, manifestKind[Role]{
+		kind:       "role",
+		locals:     manifest.Roles,
+		getRemotes: c.GetRolesWithContext,
+		getName:    func(role *Role) string { return role.Name },
+		prepare: func(local *Role) {
+			if local.Owner == "" {
+				local.Owner = authConfig.OrganizationName
+			}
+		},
+		add:    c.AddRoleWithContext,
+		update: c.UpdateRoleWithContext,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err = planManifestKind(ctx, changes, manifestKind[Permission]{
+		kind:       "permission",
+		locals:     manifest.Permissions,
+		getRemotes: c.GetPermissionsWithContext,
+		getName:    func(permission *Permission) string { return permission.Name },
+		prepare: func(local *Permission) {
+			if local.Owner == "" {
+				local.Owner = authConfig.OrganizationName
+			}
+		},
+		add:    c.AddPermissionWithContext,
+		update: c.UpdatePermissionWithContext,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return changes, nil
+}
+
+// manifestKind describes how to plan the objects of a kind of a manifest.
+type manifestKind[T any] struct {
+	kind       string
+	locals     []*T
+	getRemotes func(ctx context.Context) ([]*T, error)
+	getName    func(object *T) string
+	// prepare sets the defaults of the fields of a copy of a local object that the manifest doesn't set.
+	prepare func(local *T)
+	add     func(ctx context.Context, object *T) (bool, error)
+	update  func(ctx context.Context, object *T) (bool, error)
+}
+
+// planManifestKind appends to changes the changes needed for the objects of k.
+// The objects of the server are only listed if the manifest has objects of the kind.
+func planManifestKind[T any](ctx context.Context, changes []*Change, k manifestKind[T]) ([]*Change, error) {
+	if len(k.locals) == 0 {
+		return changes, nil
+	}
+
+	remotes, err := k.getRemotes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	remotesByName := map[string]*T{}
+	for _, remote := range remotes {
+		remotesByName[k.getName(remote)] = remote
+	}
+
+	for _, local := range k.locals {
+		object := *local
+		name := k.getName(&object)
+		remote := remotesByName[name]
+		k.prepare(&object)
+
+		if remote == nil {
+			changes = append(changes, newManifestChange(ChangeTypeCreate, k.kind, name, func() error {
+				_, err := k.add(ctx, &object)
+				return err
+			}))
+			continue
+		}
+
+		// only the fields set by the manifest are compared and sent, the other ones keep their values of the server
+		updated, changed, err := mergeManifestObject(&object, remote)
+		if err != nil {
+			return nil, fmt.Errorf("%s %s: %w", k.kind, name, err)
+		}
+		if changed {
+			changes = append(changes, newManifestChange(ChangeTypeUpdate, k.kind, name, func() error {
+				_, err := k.update(ctx, updated)
+				return err
+			}))
+		}
+	}
+
+	return changes, nil
+}
+
+// ApplyManifest makes the organization match manifest and returns the changes that were applied.
+// On error, the changes applied before the failing one are returned with the error.
+func (c *Client) ApplyManifest(manifest *Manifest) ([]*Change, error) {
+	return c.ApplyManifestWithContext(context.Background(), manifest)
+}
+
+func (c *Client) ApplyManifestWithContext(ctx context.Context, manifest *Manifest) ([]*Change, error) {
+	changes, err := c.PlanManifestWithContext(ctx, manifest)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, change := range changes {
+		err = change.apply()
+		if err != nil {
+			return changes[:i], fmt.Errorf("%s: %w", change, err)
+		}
+	}
+
+	return changes, nil
+}
+
+func newManifestChange(changeType ChangeType, kind string, name string, apply func() error) *Change {
+	return &Change{
+		Type:  changeType,
+		Kind:  kind,
+		Name:  name,
+		apply: apply,
+	}
+}
+
+// mergeManifestObject returns remote with the fields that local sets, i.e. whose values aren't zero or empty,
+// and whether any of them differs from remote. The fields set by the server are ignored.
+func mergeManifestObject[T any](local *T, remote *T) (*T, bool, error) {
+	localFields, err := getObjectFields(local)
+	if err != nil {
+		return nil, false, err
+	}
+
+	remoteFields, err := getObjectFields(remote)
+	if err != nil {
+		return nil, false, err
+	}
+
+	for _, name := range manifestIgnoredFields {
+		delete(localFields, name)
+	}
+
+	changed := false
+	for name, value := range localFields {
+		if isZeroField(value) {
+			continue
+		}
+		if !reflect.DeepEqual(normalizeEmpty(value), normalizeEmpty(remoteFields[name])) {
+			remoteFields[name] = value
+			changed = true
+		}
+	}
+	if !changed {
+		return remote, false, nil
+	}
+
+	data, err := json.Marshal(remoteFields)
+	if err != nil {
+		return nil, false, err
+	}
+
+	var merged T
+	err = json.Unmarshal(data, &merged)
+	if err != nil {
+		return nil, false, err
+	}
+	return &merged, true, nil
+}
+
+func getObjectFields(object interface{}) (map[string]interface{}, error) {
+	data, err := json.Marshal(object)
+	if err != nil {
+		return nil, err
+	}
+
+	var fields map[string]interface{}
+	err = json.Unmarshal(data, &fields)
+	return fields, err
+}
+
+// isZeroField reports whether value, a field decoded from JSON, is the zero value of its type.
+func isZeroField(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case bool:
+		return !v
+	case float64:
+		return v == 0
+	case []interface{}:
+		return len(v) == 0
+	case map[string]interface{}:
+		return len(v) == 0
+	}
+	return false
+}
+
+func normalizeEmpty(value interface{}) interface{} {
+	switch v := value.(type) {
+	case []interface{}:
+		if len(v) == 0 {
+			return nil
+		}
+	case map[string]interface{}:
+		if len(v) == 0 {
+			return nil
+		}
+	}
+	return value
+}
@@ -0,0 +1,141 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+)
+
+// roleServer emulates the role endpoints of Casdoor, storing the roles in memory.
+type roleServer struct {
+	mu      sync.Mutex
+	roles   map[string]map[string]interface{}
+	updates []map[string]interface{}
+}
+
+func newRoleServer(t *testing.T, roles ...map[string]interface{}) (*roleServer, *Client) {
+	t.Helper()
+
+	s := &roleServer{roles: map[string]map[string]interface{}{}}
+	for _, role := range roles {
+		s.roles[role["name"].(string)] = role
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		switch r.URL.Path {
+		case "/api/get-roles":
+			list := []map[string]interface{}{}
+			for _, role := range s.roles {
+				list = append(list, role)
+			}
+			_ = json.NewEncoder(w).Encode(list)
+			return
+		case "/api/add-role", "/api/update-role":
+			body, _ := ioutil.ReadAll(r.Body)
+			var role map[string]interface{}
+			if err := json.Unmarshal(body, &role); err != nil {
+				t.Errorf("bad role posted: %s", body)
+			}
+			if r.URL.Path == "/api/update-role" {
+				s.updates = append(s.updates, role)
+			}
+			s.roles[role["name"].(string)] = role
+			_, _ = w.Write([]byte(`{"status":"ok","data":"Affected"}`))
+			return
+		}
+		http.NotFound(w, r)
+	}))
+	t.Cleanup(server.Close)
+
+	client := NewClient(&AuthConfig{
+		Endpoint:         server.URL,
+		ClientId:         "client-id",
+		ClientSecret:     "client-secret",
+		OrganizationName: "org",
+		ApplicationName:  "app",
+	})
+	return s, client
+}
+
+func TestApplyManifestKeepsUnsetFields(t *testing.T) {
+	s, client := newRoleServer(t, map[string]interface{}{
+		"owner":       "org",
+		"name":        "r",
+		"displayName": "Readers",
+		"users":       []interface{}{},
+		"isEnabled":   true,
+		"custom":      "kept",
+	})
+	manifest := &Manifest{Roles: []*Role{{Name: "r", Users: []string{"org/a"}}}}
+
+	changes, err := client.ApplyManifest(manifest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(changes) != 1 || changes[0].String() != "update role r" {
+		t.Fatalf("got changes %v, want [update role r]", changes)
+	}
+
+	updated := s.updates[0]
+	if updated["displayName"] != "Readers" || updated["isEnabled"] != true || updated["custom"] != "kept" {
+		t.Errorf("the fields not set by the manifest were overwritten: %v", updated)
+	}
+	if users, _ := updated["users"].([]interface{}); len(users) != 1 || users[0] != "org/a" {
+		t.Errorf("got users %v, want [org/a]", updated["users"])
+	}
+
+	changes, err = client.ApplyManifest(manifest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(changes) != 0 {
+		t.Errorf("applying the manifest again made changes: %v", changes)
+	}
+	if len(s.updates) != 1 {
+		t.Errorf("applying the manifest again sent %d updates", len(s.updates)-1)
+	}
+}
+
+func TestApplyManifestCreatesMissingObjects(t *testing.T) {
+	s, client := newRoleServer(t)
+	manifest := &Manifest{Roles: []*Role{{Name: "r", DisplayName: "Readers", IsEnabled: true}}}
+
+	changes, err := client.ApplyManifest(manifest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(changes) != 1 || changes[0].String() != "create role r" {
+		t.Fatalf("got changes %v, want [create role r]", changes)
+	}
+	if role := s.roles["r"]; role["owner"] != "org" || role["displayName"] != "Readers" {
+		t.Errorf("got role %v", role)
+	}
+
+	changes, err = client.ApplyManifest(manifest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(changes) != 0 {
+		t.Errorf("applying the manifest again made changes: %v", changes)
+	}
+}
@@ -0,0 +1,123 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package middleware authenticates the requests to net/http servers with the Casdoor tokens they carry:
+//
+//	http.Handle("/api/", middleware.RequireAuth(apiHandler))
+//
+// and in apiHandler:
+//
+//	claims, _ := middleware.GetClaims(r.Context())
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/casdoor/casdoor-go-sdk/casdoorsdk"
+)
+
+// ErrMissingToken is passed to the ErrorHandler when the request has no Bearer token.
+var ErrMissingToken = errors.New("middleware: missing bearer token")
+
+type claimsKey struct{}
+
+// ErrorHandler writes the response to a request whose token is missing or invalid.
+type ErrorHandler func(w http.ResponseWriter, r *http.Request, err error)
+
+// Options configures the middleware returned by New.
+type Options struct {
+	// Client verifies the tokens. The package-level functions of casdoorsdk are used if it is nil.
+	Client *casdoorsdk.Client
+	// ParseOptions are the checks of the claims of the tokens, like their audience.
+	ParseOptions *casdoorsdk.ParseOptions
+	// ErrorHandler writes the response to the rejected requests, a 401 with a WWW-Authenticate header by default.
+	ErrorHandler ErrorHandler
+}
+
+// RequireAuth is New(nil), it verifies the tokens with the package-level configuration of casdoorsdk.
+func RequireAuth(next http.Handler) http.Handler {
+	return New(nil)(next)
+}
+
+// New returns a middleware that verifies the Bearer token of every request with ParseJwtTokenWithOptions,
+// and passes the requests with a valid token to the next handler, with the claims of the token in their context.
+func New(options *Options) func(next http.Handler) http.Handler {
+	if options == nil {
+		options = &Options{}
+	}
+
+	parse := casdoorsdk.ParseJwtTokenWithOptions
+	if options.Client != nil {
+		parse = options.Client.ParseJwtTokenWithOptions
+	}
+
+	errorHandler := options.ErrorHandler
+	if errorHandler == nil {
+		errorHandler = DefaultErrorHandler
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			token, ok := GetBearerToken(r)
+			if !ok {
+				errorHandler(w, r, ErrMissingToken)
+				return
+			}
+
+			claims, err := parse(token, options.ParseOptions)
+			if err != nil {
+				errorHandler(w, r, err)
+				return
+			}
+
+			next.ServeHTTP(w, r.WithContext(WithClaims(r.Context(), claims)))
+		})
+	}
+}
+
+// DefaultErrorHandler answers 401 Unauthorized, with the WWW-Authenticate header of RFC 6750.
+// The details of the error aren't sent, not to help forging tokens.
+func DefaultErrorHandler(w http.ResponseWriter, r *http.Request, err error) {
+	if errors.Is(err, ErrMissingToken) {
+		w.Header().Set("WWW-Authenticate", `Bearer`)
+	} else {
+		w.Header().Set("WWW-Authenticate", `Bearer error="invalid_token"`)
+	}
+	http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+}
+
+// GetBearerToken returns the token of the Authorization header of r, if it has the Bearer scheme.
+func GetBearerToken(r *http.Request) (string, bool) {
+	authorization := r.Header.Get("Authorization")
+	if len(authorization) < len("Bearer ") || !strings.EqualFold(authorization[:len("Bearer ")], "Bearer ") {
+		return "", false
+	}
+
+	token := strings.TrimSpace(authorization[len("Bearer "):])
+	return token, token != ""
+}
+
+// WithClaims returns a copy of ctx carrying claims, e.g. to test the handlers behind the middleware.
+func WithClaims(ctx context.Context, claims *casdoorsdk.Claims) context.Context {
+	return context.WithValue(ctx, claimsKey{}, claims)
+}
+
+// GetClaims returns the claims of the token that the middleware verified.
+func GetClaims(ctx context.Context) (*casdoorsdk.Claims, bool) {
+	claims, ok := ctx.Value(claimsKey{}).(*casdoorsdk.Claims)
+	return claims, ok
+}
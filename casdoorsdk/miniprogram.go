@@ -0,0 +1,69 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import (
+	"context"
+	"fmt"
+
+	"golang.org/x/oauth2"
+)
+
+// GetWechatMiniProgramToken signs in the user of a WeChat mini program, and returns their token and claims.
+// code is the login code returned by wx.login() in the mini program, nickName and avatarUrl are the profile
+// of the user (from wx.getUserProfile()) used when the user signs up, they can be empty.
+// The application must have a WeChat mini program provider.
+func (c *Client) GetWechatMiniProgramToken(code string, nickName string, avatarUrl string) (*oauth2.Token, *Claims, error) {
+	return c.GetWechatMiniProgramTokenWithContext(context.Background(), code, nickName, avatarUrl)
+}
+
+func (c *Client) GetWechatMiniProgramTokenWithContext(ctx context.Context, code string, nickName string, avatarUrl string) (*oauth2.Token, *Claims, error) {
+	authConfig := c.getAuthConfig()
+
+	clientSecret, err := c.getClientSecret()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	config := oauth2.Config{
+		ClientID:     authConfig.ClientId,
+		ClientSecret: clientSecret,
+		Endpoint: oauth2.Endpoint{
+			AuthURL:   fmt.Sprintf("%s/api/login/oauth/authorize", authConfig.Endpoint),
+			TokenURL:  c.getTokenUrl(authConfig.Endpoint),
+			AuthStyle: oauth2.AuthStyleInParams,
+		},
+	}
+
+	token, err := config.Exchange(c.getOAuthContext(ctx), code,
+		oauth2.SetAuthURLParam("tag", "wechat_miniprogram"),
+		oauth2.SetAuthURLParam("username", nickName),
+		oauth2.SetAuthURLParam("avatar", avatarUrl),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = c.getOAuthTokenError(token)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	claims, err := c.ParseJwtToken(token.AccessToken)
+	if err != nil {
+		return nil, nil, err
+	}
+	return token, claims, nil
+}
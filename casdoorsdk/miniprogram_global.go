@@ -0,0 +1,29 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import (
+	"context"
+
+	"golang.org/x/oauth2"
+)
+
+func GetWechatMiniProgramToken(code string, nickName string, avatarUrl string) (*oauth2.Token, *Claims, error) {
+	return globalClient.GetWechatMiniProgramToken(code, nickName, avatarUrl)
+}
+
+func GetWechatMiniProgramTokenWithContext(ctx context.Context, code string, nickName string, avatarUrl string) (*oauth2.Token, *Claims, error) {
+	return globalClient.GetWechatMiniProgramTokenWithContext(ctx, code, nickName, avatarUrl)
+}
@@ -0,0 +1,146 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// Model has the same definition as https://github.com/casdoor/casdoor/blob/master/object/model.go#L27
+type Model struct {
+	Owner       string `xorm:"varchar(100) notnull pk" json:"owner"`
+	Name        string `xorm:"varchar(100) notnull pk unique index" json:"name"`
+	CreatedTime string `xorm:"varchar(100)" json:"createdTime"`
+	DisplayName string `xorm:"varchar(100)" json:"displayName"`
+	Description string `xorm:"varchar(100)" json:"description"`
+
+	ModelText string `xorm:"mediumtext" json:"modelText"`
+	IsEnabled bool   `json:"isEnabled"`
+}
+
+func (c *Client) GetModels() ([]*Model, error) {
+	return c.GetModelsWithContext(context.Background())
+}
+
+func (c *Client) GetModelsWithContext(ctx context.Context) ([]*Model, error) {
+	authConfig := c.getAuthConfig()
+
+	queryMap := map[string]string{
+		"owner": authConfig.OrganizationName,
+	}
+
+	url := c.GetUrl("get-models", queryMap)
+
+	bytes, err := c.DoGetBytesWithContext(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	var models []*Model
+	err = c.unmarshal(bytes, &models)
+	if err != nil {
+		return nil, err
+	}
+	return models, nil
+}
+
+func (c *Client) GetPaginationModels(p int, pageSize int, queryMap map[string]string) ([]*Model, int, error) {
+	return c.GetPaginationModelsWithContext(context.Background(), p, pageSize, queryMap)
+}
+
+func (c *Client) GetPaginationModelsWithContext(ctx context.Context, p int, pageSize int, queryMap map[string]string) ([]*Model, int, error) {
+	authConfig := c.getAuthConfig()
+
+	queryMap = getPaginationQueryMap(authConfig.OrganizationName, p, pageSize, queryMap)
+
+	url := c.GetUrl("get-models", queryMap)
+
+	response, err := c.DoGetResponseWithContext(ctx, url)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	bytes, err := json.Marshal(response.Data)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var models []*Model
+	err = c.unmarshal(bytes, &models)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	count, err := getCount(response)
+	if err != nil {
+		return nil, 0, err
+	}
+	return models, count, nil
+}
+
+func (c *Client) GetModel(name string) (*Model, error) {
+	return c.GetModelWithContext(context.Background(), name)
+}
+
+func (c *Client) GetModelWithContext(ctx context.Context, name string) (*Model, error) {
+	authConfig := c.getAuthConfig()
+
+	queryMap := map[string]string{
+		"id": fmt.Sprintf("%s/%s", authConfig.OrganizationName, name),
+	}
+
+	url := c.GetUrl("get-model", queryMap)
+
+	bytes, err := c.DoGetBytesWithContext(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	var model *Model
+	err = c.unmarshal(bytes, &model)
+	if err != nil {
+		return nil, err
+	}
+	return model, nil
+}
+
+func (c *Client) UpdateModel(model *Model) (bool, error) {
+	return c.UpdateModelWithContext(context.Background(), model)
+}
+
+func (c *Client) UpdateModelWithContext(ctx context.Context, model *Model) (bool, error) {
+	_, affected, err := c.modifyModel(ctx, "update-model", model, nil)
+	return affected, err
+}
+
+func (c *Client) AddModel(model *Model) (bool, error) {
+	return c.AddModelWithContext(context.Background(), model)
+}
+
+func (c *Client) AddModelWithContext(ctx context.Context, model *Model) (bool, error) {
+	_, affected, err := c.modifyModel(ctx, "add-model", model, nil)
+	return affected, err
+}
+
+func (c *Client) DeleteModel(model *Model) (bool, error) {
+	return c.DeleteModelWithContext(context.Background(), model)
+}
+
+func (c *Client) DeleteModelWithContext(ctx context.Context, model *Model) (bool, error) {
+	_, affected, err := c.modifyModel(ctx, "delete-model", model, nil)
+	return affected, err
+}
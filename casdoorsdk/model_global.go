@@ -0,0 +1,65 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import "context"
+
+func GetModels() ([]*Model, error) {
+	return globalClient.GetModels()
+}
+
+func GetModelsWithContext(ctx context.Context) ([]*Model, error) {
+	return globalClient.GetModelsWithContext(ctx)
+}
+
+func GetPaginationModels(p int, pageSize int, queryMap map[string]string) ([]*Model, int, error) {
+	return globalClient.GetPaginationModels(p, pageSize, queryMap)
+}
+
+func GetPaginationModelsWithContext(ctx context.Context, p int, pageSize int, queryMap map[string]string) ([]*Model, int, error) {
+	return globalClient.GetPaginationModelsWithContext(ctx, p, pageSize, queryMap)
+}
+
+func GetModel(name string) (*Model, error) {
+	return globalClient.GetModel(name)
+}
+
+func GetModelWithContext(ctx context.Context, name string) (*Model, error) {
+	return globalClient.GetModelWithContext(ctx, name)
+}
+
+func UpdateModel(model *Model) (bool, error) {
+	return globalClient.UpdateModel(model)
+}
+
+func UpdateModelWithContext(ctx context.Context, model *Model) (bool, error) {
+	return globalClient.UpdateModelWithContext(ctx, model)
+}
+
+func AddModel(model *Model) (bool, error) {
+	return globalClient.AddModel(model)
+}
+
+func AddModelWithContext(ctx context.Context, model *Model) (bool, error) {
+	return globalClient.AddModelWithContext(ctx, model)
+}
+
+func DeleteModel(model *Model) (bool, error) {
+	return globalClient.DeleteModel(model)
+}
+
+func DeleteModelWithContext(ctx context.Context, model *Model) (bool, error) {
+	return globalClient.DeleteModelWithContext(ctx, model)
+}
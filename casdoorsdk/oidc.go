@@ -0,0 +1,116 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// OpenIDConfiguration is the OpenID Connect discovery document of the server,
+// see https://openid.net/specs/openid-connect-discovery-1_0.html#ProviderMetadata
+type OpenIDConfiguration struct {
+	Issuer                                 string   `json:"issuer"`
+	AuthorizationEndpoint                  string   `json:"authorization_endpoint"`
+	TokenEndpoint                          string   `json:"token_endpoint"`
+	UserinfoEndpoint                       string   `json:"userinfo_endpoint"`
+	JwksUri                                string   `json:"jwks_uri"`
+	IntrospectionEndpoint                  string   `json:"introspection_endpoint"`
+	EndSessionEndpoint                     string   `json:"end_session_endpoint"`
+	DeviceAuthorizationEndpoint            string   `json:"device_authorization_endpoint"`
+	ResponseTypesSupported                 []string `json:"response_types_supported"`
+	ResponseModesSupported                 []string `json:"response_modes_supported"`
+	GrantTypesSupported                    []string `json:"grant_types_supported"`
+	SubjectTypesSupported                  []string `json:"subject_types_supported"`
+	IdTokenSigningAlgValuesSupported       []string `json:"id_token_signing_alg_values_supported"`
+	ScopesSupported                        []string `json:"scopes_supported"`
+	ClaimsSupported                        []string `json:"claims_supported"`
+	RequestParameterSupported              bool     `json:"request_parameter_supported"`
+	RequestObjectSigningAlgValuesSupported []string `json:"request_object_signing_alg_values_supported"`
+}
+
+// openIDConfigurationTtl is how long the discovery document is used before it is refreshed.
+const openIDConfigurationTtl = time.Hour
+
+func (c *Client) fetchOpenIDConfiguration(ctx context.Context, endpoint string) (*OpenIDConfiguration, error) {
+	url := fmt.Sprintf("%s/.well-known/openid-configuration", endpoint)
+
+	bytes, err := c.DoGetBytesRawWithContext(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	var config OpenIDConfiguration
+	err = c.unmarshal(bytes, &config)
+	if err != nil {
+		return nil, err
+	}
+	return &config, nil
+}
+
+// GetOpenIDConfiguration returns the OpenID Connect discovery document of the server, fetched from
+// /.well-known/openid-configuration, to derive the URLs of its endpoints instead of hardcoding their paths.
+// The document is cached for an hour and refreshed in the background, so only the first call waits for the server.
+// The returned document is shared and must not be modified.
+func (c *Client) GetOpenIDConfiguration() (*OpenIDConfiguration, error) {
+	return c.GetOpenIDConfigurationWithContext(context.Background())
+}
+
+func (c *Client) GetOpenIDConfigurationWithContext(ctx context.Context) (*OpenIDConfiguration, error) {
+	endpoint := c.getAuthConfig().Endpoint
+	return c.openIDConfiguration.get(ctx, endpoint, openIDConfigurationTtl, c.getOpenIDConfigurationFetch(endpoint))
+}
+
+// refreshOpenIDConfiguration fetches the discovery document into the cache.
+func (c *Client) refreshOpenIDConfiguration(ctx context.Context) error {
+	endpoint := c.getAuthConfig().Endpoint
+	_, err := c.openIDConfiguration.refresh(ctx, endpoint, openIDConfigurationTtl, c.getOpenIDConfigurationFetch(endpoint))
+	return err
+}
+
+func (c *Client) getOpenIDConfigurationFetch(endpoint string) cacheFetch[*OpenIDConfiguration] {
+	return func(ctx context.Context) (*OpenIDConfiguration, error) {
+		return c.fetchOpenIDConfiguration(ctx, endpoint)
+	}
+}
+
+// getEndpointUrl returns the URL of an endpoint of the server at endpoint, with the path published by
+// the discovery document if it is cached, or else defaultPath. It never waits for the server: a missing
+// document is fetched in the background for the next calls. The path is joined to the configured endpoint,
+// since the discovery document has the public URLs of the server, which the backend may not reach.
+func (c *Client) getEndpointUrl(endpoint string, getDiscoveredUrl func(config *OpenIDConfiguration) string, defaultPath string) string {
+	config, ok := c.openIDConfiguration.peek(endpoint, openIDConfigurationTtl, c.getOpenIDConfigurationFetch(endpoint), true)
+	if ok {
+		discoveredUrl, err := url.Parse(getDiscoveredUrl(config))
+		if err == nil && discoveredUrl.Path != "" {
+			return endpoint + discoveredUrl.Path
+		}
+	}
+	return endpoint + defaultPath
+}
+
+func (c *Client) getTokenUrl(endpoint string) string {
+	return c.getEndpointUrl(endpoint, func(config *OpenIDConfiguration) string {
+		return config.TokenEndpoint
+	}, "/api/login/oauth/access_token")
+}
+
+func (c *Client) getJwksUrl(endpoint string) string {
+	return c.getEndpointUrl(endpoint, func(config *OpenIDConfiguration) string {
+		return config.JwksUri
+	}, "/.well-known/jwks")
+}
@@ -0,0 +1,111 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestTokenUrlIsResolvedFromDiscovery(t *testing.T) {
+	var customTokenRequests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/.well-known/openid-configuration":
+			_, _ = w.Write([]byte(`{"issuer":"https://public.example.com","token_endpoint":"https://public.example.com/api/custom/token"}`))
+		case "/api/custom/token":
+			atomic.AddInt32(&customTokenRequests, 1)
+			writeServiceToken(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+	client := NewClient(&AuthConfig{Endpoint: server.URL, ClientId: "client-id", ClientSecret: "client-secret"})
+
+	if got := client.getTokenUrl(server.URL); got != server.URL+"/api/login/oauth/access_token" {
+		t.Errorf("got token url %s before the discovery document is fetched", got)
+	}
+
+	_, err := client.GetOpenIDConfiguration()
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.GetClientCredentialsToken("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := atomic.LoadInt32(&customTokenRequests); n != 1 {
+		t.Errorf("the discovered token endpoint got %d requests, want 1", n)
+	}
+}
+
+func TestRefreshingCache(t *testing.T) {
+	var cache refreshingCache[int]
+	var fetches int32
+	release := make(chan struct{})
+	fetch := func(ctx context.Context) (int, error) {
+		<-release
+		return int(atomic.AddInt32(&fetches, 1)), nil
+	}
+
+	// the concurrent callers share the fetch, and peeking doesn't wait for it
+	results := make(chan int)
+	for i := 0; i < 5; i++ {
+		go func() {
+			value, err := cache.get(context.Background(), "a", time.Hour, fetch)
+			if err != nil {
+				t.Error(err)
+			}
+			results <- value
+		}()
+	}
+	time.Sleep(50 * time.Millisecond)
+	if _, ok := cache.peek("a", time.Hour, fetch, false); ok {
+		t.Error("a value is cached before being fetched")
+	}
+	close(release)
+	for i := 0; i < 5; i++ {
+		if value := <-results; value != 1 {
+			t.Errorf("got %d, want the value of the first fetch", value)
+		}
+	}
+
+	// an expired value is served while it is refreshed in the background
+	_, err := cache.refresh(context.Background(), "a", 0, fetch)
+	if err != nil {
+		t.Fatal(err)
+	}
+	value, err := cache.get(context.Background(), "a", time.Hour, fetch)
+	if err != nil || value != 2 {
+		t.Errorf("got %d, %v, want the expired value", value, err)
+	}
+	deadline := time.Now().Add(time.Second)
+	for atomic.LoadInt32(&fetches) != 3 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if n := atomic.LoadInt32(&fetches); n != 3 {
+		t.Errorf("the expired value wasn't refreshed in the background")
+	}
+
+	// a value cached for another key is never served
+	if _, ok := cache.peek("b", time.Hour, fetch, false); ok {
+		t.Error("the value of another key is served")
+	}
+}
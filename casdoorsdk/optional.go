@@ -0,0 +1,95 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import (
+	"reflect"
+	"strings"
+	"unicode"
+)
+
+// Optional is a value that may be left unset.
+// It lets update calls tell "set this field, even to its zero value" from "leave this field unchanged",
+// which the zero-value based object structs cannot express.
+type Optional[T any] struct {
+	value T
+	set   bool
+}
+
+// Some returns an Optional that is set to value.
+func Some[T any](value T) Optional[T] {
+	return Optional[T]{value: value, set: true}
+}
+
+// Get returns the value of the Optional and whether it is set.
+func (o Optional[T]) Get() (T, bool) {
+	return o.value, o.set
+}
+
+// IsSet reports whether the Optional is set.
+func (o Optional[T]) IsSet() bool {
+	return o.set
+}
+
+func (o Optional[T]) get() (interface{}, bool) {
+	return o.value, o.set
+}
+
+// optionalValue is implemented by every Optional type.
+type optionalValue interface {
+	get() (interface{}, bool)
+}
+
+// applyPatch copies the set Optional fields of patch into the same-named fields of object
+// and returns the database columns of the copied fields.
+func applyPatch(object interface{}, patch interface{}) []string {
+	objectValue := reflect.ValueOf(object).Elem()
+	patchValue := reflect.ValueOf(patch).Elem()
+	patchType := patchValue.Type()
+
+	var columns []string
+	for i := 0; i < patchType.NumField(); i++ {
+		optional, ok := patchValue.Field(i).Interface().(optionalValue)
+		if !ok {
+			continue
+		}
+
+		value, set := optional.get()
+		if !set {
+			continue
+		}
+
+		name := patchType.Field(i).Name
+		objectValue.FieldByName(name).Set(reflect.ValueOf(value))
+		columns = append(columns, getColumnName(name))
+	}
+
+	return columns
+}
+
+// getColumnName returns the database column of a field, following the snake case mapping of the Casdoor server.
+func getColumnName(fieldName string) string {
+	var sb strings.Builder
+	for i, r := range fieldName {
+		if unicode.IsUpper(r) {
+			if i != 0 {
+				sb.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		sb.WriteRune(r)
+	}
+	return sb.String()
+}
@@ -14,7 +14,11 @@
 
 package casdoorsdk
 
-import "encoding/json"
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
 
 type AccountItem struct {
 	Name       string `json:"name"`
@@ -23,6 +27,14 @@ type AccountItem struct {
 	ModifyRule string `json:"modifyRule"`
 }
 
+type ThemeData struct {
+	ThemeType    string `json:"themeType"`
+	ColorPrimary string `json:"colorPrimary"`
+	BorderRadius int    `json:"borderRadius"`
+	IsCompact    bool   `json:"isCompact"`
+	IsEnabled    bool   `json:"isEnabled"`
+}
+
 // Organization has the same definition as https://github.com/casdoor/casdoor/blob/master/object/organization.go#L25
 type Organization struct {
 	Owner       string `xorm:"varchar(100) notnull pk" json:"owner"`
@@ -31,6 +43,8 @@ type Organization struct {
 
 	DisplayName        string   `xorm:"varchar(100)" json:"displayName"`
 	WebsiteUrl         string   `xorm:"varchar(100)" json:"websiteUrl"`
+	Logo               string   `xorm:"varchar(200)" json:"logo"`
+	LogoDark           string   `xorm:"varchar(200)" json:"logoDark"`
 	Favicon            string   `xorm:"varchar(100)" json:"favicon"`
 	PasswordType       string   `xorm:"varchar(100)" json:"passwordType"`
 	PasswordSalt       string   `xorm:"varchar(100)" json:"passwordSalt"`
@@ -42,10 +56,95 @@ type Organization struct {
 	EnableSoftDeletion bool     `json:"enableSoftDeletion"`
 	IsProfilePublic    bool     `json:"isProfilePublic"`
 
+	ThemeData    *ThemeData     `xorm:"json" json:"themeData"`
 	AccountItems []*AccountItem `xorm:"varchar(3000)" json:"accountItems"`
 }
 
-func AddOrganization(organization *Organization) (bool, error) {
+// Branding is what the login and account pages of an organization look like.
+type Branding struct {
+	DisplayName string
+	WebsiteUrl  string
+	Logo        string
+	LogoDark    string
+	Favicon     string
+	// Theme is the theme of the application if it has one, or else the theme of the organization. It can be nil.
+	Theme             *ThemeData
+	FormCss           string
+	FormBackgroundUrl string
+}
+
+func (c *Client) GetOrganization(name string) (*Organization, error) {
+	return c.GetOrganizationWithContext(context.Background(), name)
+}
+
+func (c *Client) GetOrganizationWithContext(ctx context.Context, name string) (*Organization, error) {
+	queryMap := map[string]string{
+		"id": fmt.Sprintf("admin/%s", name),
+	}
+
+	url := c.GetUrl("get-organization", queryMap)
+
+	bytes, err := c.DoGetBytesRawWithContext(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	var organization *Organization
+	err = c.unmarshal(bytes, &organization)
+	if err != nil {
+		return nil, err
+	}
+	if organization == nil {
+		return nil, c.newAPIError(fmt.Sprintf("the organization: %s doesn't exist", name))
+	}
+	return organization, nil
+}
+
+// GetOrganizationBranding returns the branding of the organization, merged with the one of its default application
+// the way the Casdoor web UI does, so that products can render pages matching the Casdoor ones of each tenant.
+func (c *Client) GetOrganizationBranding(name string) (*Branding, error) {
+	return c.GetOrganizationBrandingWithContext(context.Background(), name)
+}
+
+func (c *Client) GetOrganizationBrandingWithContext(ctx context.Context, name string) (*Branding, error) {
+	organization, err := c.GetOrganizationWithContext(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	branding := &Branding{
+		DisplayName: organization.DisplayName,
+		WebsiteUrl:  organization.WebsiteUrl,
+		Logo:        organization.Logo,
+		LogoDark:    organization.LogoDark,
+		Favicon:     organization.Favicon,
+		Theme:       organization.ThemeData,
+	}
+	if organization.DefaultApplication == "" {
+		return branding, nil
+	}
+
+	application, err := c.GetApplicationWithContext(ctx, organization.DefaultApplication)
+	if err != nil {
+		return nil, err
+	}
+
+	if application.Logo != "" {
+		branding.Logo = application.Logo
+	}
+	if application.ThemeData != nil && application.ThemeData.IsEnabled {
+		branding.Theme = application.ThemeData
+	}
+	branding.FormCss = application.FormCss
+	branding.FormBackgroundUrl = application.FormBackgroundUrl
+	return branding, nil
+}
+
+func (c *Client) AddOrganization(organization *Organization) (bool, error) {
+	return c.AddOrganizationWithContext(context.Background(), organization)
+}
+
+func (c *Client) AddOrganizationWithContext(ctx context.Context, organization *Organization) (bool, error) {
 	if organization.Owner == "" {
 		organization.Owner = "admin"
 	}
@@ -54,15 +153,19 @@ func AddOrganization(organization *Organization) (bool, error) {
 		return false, err
 	}
 
-	resp, err := DoPost("add-organization", nil, postBytes, false, false)
+	resp, err := c.DoPostWithContext(ctx, "add-organization", nil, postBytes, false, false)
 	if err != nil {
 		return false, err
 	}
 
-	return resp.Data == "Affected", nil
+	return isAffected(resp), nil
+}
+
+func (c *Client) DeleteOrganization(name string) (bool, error) {
+	return c.DeleteOrganizationWithContext(context.Background(), name)
 }
 
-func DeleteOrganization(name string) (bool, error) {
+func (c *Client) DeleteOrganizationWithContext(ctx context.Context, name string) (bool, error) {
 	organization := Organization{
 		Owner: "admin",
 		Name:  name,
@@ -72,10 +175,10 @@ func DeleteOrganization(name string) (bool, error) {
 		return false, err
 	}
 
-	resp, err := DoPost("delete-organization", nil, postBytes, false, false)
+	resp, err := c.DoPostWithContext(ctx, "delete-organization", nil, postBytes, false, false)
 	if err != nil {
 		return false, err
 	}
 
-	return resp.Data == "Affected", nil
+	return isAffected(resp), nil
 }
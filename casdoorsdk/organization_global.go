@@ -0,0 +1,49 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import "context"
+
+func GetOrganization(name string) (*Organization, error) {
+	return globalClient.GetOrganization(name)
+}
+
+func GetOrganizationWithContext(ctx context.Context, name string) (*Organization, error) {
+	return globalClient.GetOrganizationWithContext(ctx, name)
+}
+
+func GetOrganizationBranding(name string) (*Branding, error) {
+	return globalClient.GetOrganizationBranding(name)
+}
+
+func GetOrganizationBrandingWithContext(ctx context.Context, name string) (*Branding, error) {
+	return globalClient.GetOrganizationBrandingWithContext(ctx, name)
+}
+
+func AddOrganization(organization *Organization) (bool, error) {
+	return globalClient.AddOrganization(organization)
+}
+
+func AddOrganizationWithContext(ctx context.Context, organization *Organization) (bool, error) {
+	return globalClient.AddOrganizationWithContext(ctx, organization)
+}
+
+func DeleteOrganization(name string) (bool, error) {
+	return globalClient.DeleteOrganization(name)
+}
+
+func DeleteOrganizationWithContext(ctx context.Context, name string) (bool, error) {
+	return globalClient.DeleteOrganizationWithContext(ctx, name)
+}
@@ -0,0 +1,117 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package otelcasdoor traces the requests of the SDK to the Casdoor server with OpenTelemetry:
+// each request is a client span named after its action, like "casdoor.get-user", in the trace of the context
+// of the call, which is propagated to the server.
+//
+//	client.SetHttpClient(otelcasdoor.NewHttpClient(&http.Client{}, nil))
+//
+//	user, err := client.GetUserWithContext(ctx, "alice")
+package otelcasdoor
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/casdoor/casdoor-go-sdk/casdoorsdk"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const instrumentationName = "github.com/casdoor/casdoor-go-sdk/casdoorsdk/otelcasdoor"
+
+// ActionKey is the attribute of the spans holding the action of the request, like "get-user".
+const ActionKey = attribute.Key("casdoor.action")
+
+// Options configures the tracing.
+type Options struct {
+	// TracerProvider creates the tracer of the spans. The global one is used if it is nil.
+	TracerProvider trace.TracerProvider
+	// Propagators inject the trace into the headers of the requests. The global ones are used if it is nil.
+	Propagators propagation.TextMapPropagator
+}
+
+type httpClient struct {
+	next        casdoorsdk.HttpClient
+	tracer      trace.Tracer
+	propagators propagation.TextMapPropagator
+}
+
+// NewHttpClient returns an HttpClient tracing the requests sent through next, to be set with SetHttpClient.
+// options can be nil.
+func NewHttpClient(next casdoorsdk.HttpClient, options *Options) casdoorsdk.HttpClient {
+	if options == nil {
+		options = &Options{}
+	}
+
+	tracerProvider := options.TracerProvider
+	if tracerProvider == nil {
+		tracerProvider = otel.GetTracerProvider()
+	}
+	propagators := options.Propagators
+	if propagators == nil {
+		propagators = otel.GetTextMapPropagator()
+	}
+
+	return &httpClient{
+		next:        next,
+		tracer:      tracerProvider.Tracer(instrumentationName),
+		propagators: propagators,
+	}
+}
+
+func (c *httpClient) Do(req *http.Request) (*http.Response, error) {
+	action := getAction(req.URL.Path)
+	ctx, span := c.tracer.Start(req.Context(), "casdoor."+action,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			ActionKey.String(action),
+			semconv.HTTPMethodKey.String(req.Method),
+			semconv.HTTPURLKey.String(casdoorsdk.RedactUrl(req.URL.String())),
+			semconv.NetPeerNameKey.String(req.URL.Hostname()),
+		),
+	)
+	defer span.End()
+
+	req = req.Clone(ctx)
+	c.propagators.Inject(ctx, propagation.HeaderCarrier(req.Header))
+
+	resp, err := c.next.Do(req)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	span.SetAttributes(semconv.HTTPStatusCodeKey.Int(resp.StatusCode))
+	if resp.StatusCode >= http.StatusBadRequest {
+		span.SetStatus(codes.Error, fmt.Sprintf("HTTP status %d", resp.StatusCode))
+	}
+	return resp, nil
+}
+
+// getAction returns the API action of path, e.g. "get-user" for "/api/get-user".
+func getAction(path string) string {
+	i := strings.Index(path, "/api/")
+	if i == -1 {
+		return strings.TrimPrefix(path, "/")
+	}
+	return path[i+len("/api/"):]
+}
@@ -0,0 +1,312 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import "context"
+
+// pageFetcher fetches page p, counted from 1, and returns its objects and the total count of objects.
+type pageFetcher[T any] func(p int, pageSize int) ([]T, int, error)
+
+// Pager pages through a paginated list, so that pagination behaves the same for every kind of object.
+type Pager[T any] struct {
+	fetch    pageFetcher[T]
+	pageSize int
+
+	// page is the last fetched page, 0 before the first one is fetched.
+	page int
+	// totalCount is the count of objects returned with the last page, -1 until a page is fetched.
+	totalCount int
+	done       bool
+}
+
+func newPager[T any](fetch pageFetcher[T], pageSize int) *Pager[T] {
+	if pageSize <= 0 {
+		pageSize = defaultIteratorPageSize
+	}
+
+	return &Pager[T]{
+		fetch:      fetch,
+		pageSize:   pageSize,
+		totalCount: -1,
+	}
+}
+
+// NextPage fetches the next page. It returns an empty page once the end of the list is reached.
+func (p *Pager[T]) NextPage() ([]T, error) {
+	if p.done {
+		return nil, nil
+	}
+
+	objects, totalCount, err := p.fetch(p.page+1, p.pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	p.page++
+	p.totalCount = totalCount
+	if len(objects) < p.pageSize || p.page*p.pageSize >= totalCount {
+		p.done = true
+	}
+	return objects, nil
+}
+
+// HasNextPage reports whether NextPage may return more objects.
+func (p *Pager[T]) HasNextPage() bool {
+	return !p.done
+}
+
+// TotalCount returns the count of objects in the list, fetching it if no page has been fetched yet.
+func (p *Pager[T]) TotalCount() (int, error) {
+	if p.totalCount != -1 {
+		return p.totalCount, nil
+	}
+
+	_, totalCount, err := p.fetch(1, 1)
+	if err != nil {
+		return 0, err
+	}
+
+	p.totalCount = totalCount
+	return totalCount, nil
+}
+
+// All fetches the remaining pages and returns their objects.
+func (p *Pager[T]) All() ([]T, error) {
+	var res []T
+	err := p.ForEach(func(object T) error {
+		res = append(res, object)
+		return nil
+	})
+	return res, err
+}
+
+// ForEach fetches the remaining pages and calls f with each of their objects, stopping at the first error.
+func (p *Pager[T]) ForEach(f func(T) error) error {
+	for p.HasNextPage() {
+		objects, err := p.NextPage()
+		if err != nil {
+			return err
+		}
+
+		for _, object := range objects {
+			err = f(object)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Iterate returns an Iterator over the list, see IteratorOptions for its page size and prefetching.
+func (p *Pager[T]) Iterate(options *IteratorOptions) *Iterator[T] {
+	return newIterator(p.fetch, options)
+}
+
+// NewUserPager returns a Pager over the users, with pages of pageSize objects.
+func (c *Client) NewUserPager(pageSize int) *Pager[*User] {
+	return c.NewUserPagerWithContext(context.Background(), pageSize)
+}
+
+func (c *Client) NewUserPagerWithContext(ctx context.Context, pageSize int) *Pager[*User] {
+	return newPager(getPages(ctx, c.GetPaginationUsersWithContext), pageSize)
+}
+
+// NewRolePager returns a Pager over the roles, with pages of pageSize objects.
+func (c *Client) NewRolePager(pageSize int) *Pager[*Role] {
+	return c.NewRolePagerWithContext(context.Background(), pageSize)
+}
+
+func (c *Client) NewRolePagerWithContext(ctx context.Context, pageSize int) *Pager[*Role] {
+	return newPager(getPages(ctx, c.GetPaginationRolesWithContext), pageSize)
+}
+
+// NewPermissionPager returns a Pager over the permissions, with pages of pageSize objects.
+func (c *Client) NewPermissionPager(pageSize int) *Pager[*Permission] {
+	return c.NewPermissionPagerWithContext(context.Background(), pageSize)
+}
+
+func (c *Client) NewPermissionPagerWithContext(ctx context.Context, pageSize int) *Pager[*Permission] {
+	return newPager(getPages(ctx, c.GetPaginationPermissionsWithContext), pageSize)
+}
+
+// NewTokenPager returns a Pager over the tokens, with pages of pageSize objects.
+func (c *Client) NewTokenPager(pageSize int) *Pager[*Token] {
+	return c.NewTokenPagerWithContext(context.Background(), pageSize)
+}
+
+func (c *Client) NewTokenPagerWithContext(ctx context.Context, pageSize int) *Pager[*Token] {
+	return newPager(getPages(ctx, c.GetPaginationTokensWithContext), pageSize)
+}
+
+// NewRecordPager returns a Pager over the records, with pages of pageSize objects.
+func (c *Client) NewRecordPager(pageSize int) *Pager[*Record] {
+	return c.NewRecordPagerWithContext(context.Background(), pageSize)
+}
+
+func (c *Client) NewRecordPagerWithContext(ctx context.Context, pageSize int) *Pager[*Record] {
+	return newPager(getPages(ctx, c.GetPaginationRecordsWithContext), pageSize)
+}
+
+// NewGroupPager returns a Pager over the groups, with pages of pageSize objects.
+func (c *Client) NewGroupPager(pageSize int) *Pager[*Group] {
+	return c.NewGroupPagerWithContext(context.Background(), pageSize)
+}
+
+func (c *Client) NewGroupPagerWithContext(ctx context.Context, pageSize int) *Pager[*Group] {
+	return newPager(getPages(ctx, c.GetPaginationGroupsWithContext), pageSize)
+}
+
+// NewAdapterPager returns a Pager over the adapters, with pages of pageSize objects.
+func (c *Client) NewAdapterPager(pageSize int) *Pager[*Adapter] {
+	return c.NewAdapterPagerWithContext(context.Background(), pageSize)
+}
+
+func (c *Client) NewAdapterPagerWithContext(ctx context.Context, pageSize int) *Pager[*Adapter] {
+	return newPager(getPages(ctx, c.GetPaginationAdaptersWithContext), pageSize)
+}
+
+// NewEnforcerPager returns a Pager over the enforcers, with pages of pageSize objects.
+func (c *Client) NewEnforcerPager(pageSize int) *Pager[*Enforcer] {
+	return c.NewEnforcerPagerWithContext(context.Background(), pageSize)
+}
+
+func (c *Client) NewEnforcerPagerWithContext(ctx context.Context, pageSize int) *Pager[*Enforcer] {
+	return newPager(getPages(ctx, c.GetPaginationEnforcersWithContext), pageSize)
+}
+
+// NewModelPager returns a Pager over the models, with pages of pageSize objects.
+func (c *Client) NewModelPager(pageSize int) *Pager[*Model] {
+	return c.NewModelPagerWithContext(context.Background(), pageSize)
+}
+
+func (c *Client) NewModelPagerWithContext(ctx context.Context, pageSize int) *Pager[*Model] {
+	return newPager(getPages(ctx, c.GetPaginationModelsWithContext), pageSize)
+}
+
+// NewInvitationPager returns a Pager over the invitations, with pages of pageSize objects.
+func (c *Client) NewInvitationPager(pageSize int) *Pager[*Invitation] {
+	return c.NewInvitationPagerWithContext(context.Background(), pageSize)
+}
+
+func (c *Client) NewInvitationPagerWithContext(ctx context.Context, pageSize int) *Pager[*Invitation] {
+	return newPager(getPages(ctx, c.GetPaginationInvitationsWithContext), pageSize)
+}
+
+// NewResourcePager returns a Pager over the resources, with pages of pageSize objects.
+func (c *Client) NewResourcePager(pageSize int) *Pager[*Resource] {
+	return c.NewResourcePagerWithContext(context.Background(), pageSize)
+}
+
+func (c *Client) NewResourcePagerWithContext(ctx context.Context, pageSize int) *Pager[*Resource] {
+	return newPager(getPages(ctx, c.GetPaginationResourcesWithContext), pageSize)
+}
+
+// NewProductPager returns a Pager over the products, with pages of pageSize objects.
+func (c *Client) NewProductPager(pageSize int) *Pager[*Product] {
+	return c.NewProductPagerWithContext(context.Background(), pageSize)
+}
+
+func (c *Client) NewProductPagerWithContext(ctx context.Context, pageSize int) *Pager[*Product] {
+	return newPager(getPages(ctx, c.GetPaginationProductsWithContext), pageSize)
+}
+
+// NewPaymentPager returns a Pager over the payments, with pages of pageSize objects.
+func (c *Client) NewPaymentPager(pageSize int) *Pager[*Payment] {
+	return c.NewPaymentPagerWithContext(context.Background(), pageSize)
+}
+
+func (c *Client) NewPaymentPagerWithContext(ctx context.Context, pageSize int) *Pager[*Payment] {
+	return newPager(getPages(ctx, c.GetPaginationPaymentsWithContext), pageSize)
+}
+
+// NewPlanPager returns a Pager over the plans, with pages of pageSize objects.
+func (c *Client) NewPlanPager(pageSize int) *Pager[*Plan] {
+	return c.NewPlanPagerWithContext(context.Background(), pageSize)
+}
+
+func (c *Client) NewPlanPagerWithContext(ctx context.Context, pageSize int) *Pager[*Plan] {
+	return newPager(getPages(ctx, c.GetPaginationPlansWithContext), pageSize)
+}
+
+// NewPricingPager returns a Pager over the pricings, with pages of pageSize objects.
+func (c *Client) NewPricingPager(pageSize int) *Pager[*Pricing] {
+	return c.NewPricingPagerWithContext(context.Background(), pageSize)
+}
+
+func (c *Client) NewPricingPagerWithContext(ctx context.Context, pageSize int) *Pager[*Pricing] {
+	return newPager(getPages(ctx, c.GetPaginationPricingsWithContext), pageSize)
+}
+
+// NewSubscriptionPager returns a Pager over the subscriptions, with pages of pageSize objects.
+func (c *Client) NewSubscriptionPager(pageSize int) *Pager[*Subscription] {
+	return c.NewSubscriptionPagerWithContext(context.Background(), pageSize)
+}
+
+func (c *Client) NewSubscriptionPagerWithContext(ctx context.Context, pageSize int) *Pager[*Subscription] {
+	return newPager(getPages(ctx, c.GetPaginationSubscriptionsWithContext), pageSize)
+}
+
+// NewProviderPager returns a Pager over the providers, with pages of pageSize objects.
+func (c *Client) NewProviderPager(pageSize int) *Pager[*Provider] {
+	return c.NewProviderPagerWithContext(context.Background(), pageSize)
+}
+
+func (c *Client) NewProviderPagerWithContext(ctx context.Context, pageSize int) *Pager[*Provider] {
+	return newPager(getPages(ctx, c.GetPaginationProvidersWithContext), pageSize)
+}
+
+// NewCertPager returns a Pager over the certs, with pages of pageSize objects.
+func (c *Client) NewCertPager(pageSize int) *Pager[*Cert] {
+	return c.NewCertPagerWithContext(context.Background(), pageSize)
+}
+
+func (c *Client) NewCertPagerWithContext(ctx context.Context, pageSize int) *Pager[*Cert] {
+	return newPager(getPages(ctx, c.GetPaginationCertsWithContext), pageSize)
+}
+
+// NewApplicationPager returns a Pager over the applications, with pages of pageSize objects.
+func (c *Client) NewApplicationPager(pageSize int) *Pager[*Application] {
+	return c.NewApplicationPagerWithContext(context.Background(), pageSize)
+}
+
+func (c *Client) NewApplicationPagerWithContext(ctx context.Context, pageSize int) *Pager[*Application] {
+	return newPager(getPages(ctx, c.GetPaginationApplicationsWithContext), pageSize)
+}
+
+// NewWebhookPager returns a Pager over the webhooks, with pages of pageSize objects.
+func (c *Client) NewWebhookPager(pageSize int) *Pager[*Webhook] {
+	return c.NewWebhookPagerWithContext(context.Background(), pageSize)
+}
+
+func (c *Client) NewWebhookPagerWithContext(ctx context.Context, pageSize int) *Pager[*Webhook] {
+	return newPager(getPages(ctx, c.GetPaginationWebhooksWithContext), pageSize)
+}
+
+// NewSyncerPager returns a Pager over the syncers, with pages of pageSize objects.
+func (c *Client) NewSyncerPager(pageSize int) *Pager[*Syncer] {
+	return c.NewSyncerPagerWithContext(context.Background(), pageSize)
+}
+
+func (c *Client) NewSyncerPagerWithContext(ctx context.Context, pageSize int) *Pager[*Syncer] {
+	return newPager(getPages(ctx, c.GetPaginationSyncersWithContext), pageSize)
+}
+
+// getPages returns the pageFetcher of a GetPagination*WithContext function, fetching the pages with ctx.
+func getPages[T any](ctx context.Context, getPagination func(ctx context.Context, p int, pageSize int, queryMap map[string]string) ([]T, int, error)) pageFetcher[T] {
+	return func(p int, pageSize int) ([]T, int, error) {
+		return getPagination(ctx, p, pageSize, nil)
+	}
+}
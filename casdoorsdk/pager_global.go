@@ -0,0 +1,185 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import "context"
+
+func NewUserPager(pageSize int) *Pager[*User] {
+	return globalClient.NewUserPager(pageSize)
+}
+
+func NewUserPagerWithContext(ctx context.Context, pageSize int) *Pager[*User] {
+	return globalClient.NewUserPagerWithContext(ctx, pageSize)
+}
+
+func NewRolePager(pageSize int) *Pager[*Role] {
+	return globalClient.NewRolePager(pageSize)
+}
+
+func NewRolePagerWithContext(ctx context.Context, pageSize int) *Pager[*Role] {
+	return globalClient.NewRolePagerWithContext(ctx, pageSize)
+}
+
+func NewPermissionPager(pageSize int) *Pager[*Permission] {
+	return globalClient.NewPermissionPager(pageSize)
+}
+
+func NewPermissionPagerWithContext(ctx context.Context, pageSize int) *Pager[*Permission] {
+	return globalClient.NewPermissionPagerWithContext(ctx, pageSize)
+}
+
+func NewTokenPager(pageSize int) *Pager[*Token] {
+	return globalClient.NewTokenPager(pageSize)
+}
+
+func NewTokenPagerWithContext(ctx context.Context, pageSize int) *Pager[*Token] {
+	return globalClient.NewTokenPagerWithContext(ctx, pageSize)
+}
+
+func NewRecordPager(pageSize int) *Pager[*Record] {
+	return globalClient.NewRecordPager(pageSize)
+}
+
+func NewRecordPagerWithContext(ctx context.Context, pageSize int) *Pager[*Record] {
+	return globalClient.NewRecordPagerWithContext(ctx, pageSize)
+}
+
+func NewGroupPager(pageSize int) *Pager[*Group] {
+	return globalClient.NewGroupPager(pageSize)
+}
+
+func NewGroupPagerWithContext(ctx context.Context, pageSize int) *Pager[*Group] {
+	return globalClient.NewGroupPagerWithContext(ctx, pageSize)
+}
+
+func NewAdapterPager(pageSize int) *Pager[*Adapter] {
+	return globalClient.NewAdapterPager(pageSize)
+}
+
+func NewAdapterPagerWithContext(ctx context.Context, pageSize int) *Pager[*Adapter] {
+	return globalClient.NewAdapterPagerWithContext(ctx, pageSize)
+}
+
+func NewEnforcerPager(pageSize int) *Pager[*Enforcer] {
+	return globalClient.NewEnforcerPager(pageSize)
+}
+
+func NewEnforcerPagerWithContext(ctx context.Context, pageSize int) *Pager[*Enforcer] {
+	return globalClient.NewEnforcerPagerWithContext(ctx, pageSize)
+}
+
+func NewModelPager(pageSize int) *Pager[*Model] {
+	return globalClient.NewModelPager(pageSize)
+}
+
+func NewModelPagerWithContext(ctx context.Context, pageSize int) *Pager[*Model] {
+	return globalClient.NewModelPagerWithContext(ctx, pageSize)
+}
+
+func NewInvitationPager(pageSize int) *Pager[*Invitation] {
+	return globalClient.NewInvitationPager(pageSize)
+}
+
+func NewInvitationPagerWithContext(ctx context.Context, pageSize int) *Pager[*Invitation] {
+	return globalClient.NewInvitationPagerWithContext(ctx, pageSize)
+}
+
+func NewResourcePager(pageSize int) *Pager[*Resource] {
+	return globalClient.NewResourcePager(pageSize)
+}
+
+func NewResourcePagerWithContext(ctx context.Context, pageSize int) *Pager[*Resource] {
+	return globalClient.NewResourcePagerWithContext(ctx, pageSize)
+}
+
+func NewProductPager(pageSize int) *Pager[*Product] {
+	return globalClient.NewProductPager(pageSize)
+}
+
+func NewProductPagerWithContext(ctx context.Context, pageSize int) *Pager[*Product] {
+	return globalClient.NewProductPagerWithContext(ctx, pageSize)
+}
+
+func NewPaymentPager(pageSize int) *Pager[*Payment] {
+	return globalClient.NewPaymentPager(pageSize)
+}
+
+func NewPaymentPagerWithContext(ctx context.Context, pageSize int) *Pager[*Payment] {
+	return globalClient.NewPaymentPagerWithContext(ctx, pageSize)
+}
+
+func NewPlanPager(pageSize int) *Pager[*Plan] {
+	return globalClient.NewPlanPager(pageSize)
+}
+
+func NewPlanPagerWithContext(ctx context.Context, pageSize int) *Pager[*Plan] {
+	return globalClient.NewPlanPagerWithContext(ctx, pageSize)
+}
+
+func NewPricingPager(pageSize int) *Pager[*Pricing] {
+	return globalClient.NewPricingPager(pageSize)
+}
+
+func NewPricingPagerWithContext(ctx context.Context, pageSize int) *Pager[*Pricing] {
+	return globalClient.NewPricingPagerWithContext(ctx, pageSize)
+}
+
+func NewSubscriptionPager(pageSize int) *Pager[*Subscription] {
+	return globalClient.NewSubscriptionPager(pageSize)
+}
+
+func NewSubscriptionPagerWithContext(ctx context.Context, pageSize int) *Pager[*Subscription] {
+	return globalClient.NewSubscriptionPagerWithContext(ctx, pageSize)
+}
+
+func NewProviderPager(pageSize int) *Pager[*Provider] {
+	return globalClient.NewProviderPager(pageSize)
+}
+
+func NewProviderPagerWithContext(ctx context.Context, pageSize int) *Pager[*Provider] {
+	return globalClient.NewProviderPagerWithContext(ctx, pageSize)
+}
+
+func NewCertPager(pageSize int) *Pager[*Cert] {
+	return globalClient.NewCertPager(pageSize)
+}
+
+func NewCertPagerWithContext(ctx context.Context, pageSize int) *Pager[*Cert] {
+	return globalClient.NewCertPagerWithContext(ctx, pageSize)
+}
+
+func NewApplicationPager(pageSize int) *Pager[*Application] {
+	return globalClient.NewApplicationPager(pageSize)
+}
+
+func NewApplicationPagerWithContext(ctx context.Context, pageSize int) *Pager[*Application] {
+	return globalClient.NewApplicationPagerWithContext(ctx, pageSize)
+}
+
+func NewWebhookPager(pageSize int) *Pager[*Webhook] {
+	return globalClient.NewWebhookPager(pageSize)
+}
+
+func NewWebhookPagerWithContext(ctx context.Context, pageSize int) *Pager[*Webhook] {
+	return globalClient.NewWebhookPagerWithContext(ctx, pageSize)
+}
+
+func NewSyncerPager(pageSize int) *Pager[*Syncer] {
+	return globalClient.NewSyncerPager(pageSize)
+}
+
+func NewSyncerPagerWithContext(ctx context.Context, pageSize int) *Pager[*Syncer] {
+	return globalClient.NewSyncerPagerWithContext(ctx, pageSize)
+}
@@ -0,0 +1,227 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// Payment has the same definition as https://github.com/casdoor/casdoor/blob/master/object/payment.go#L25
+type Payment struct {
+	Owner       string `xorm:"varchar(100) notnull pk" json:"owner"`
+	Name        string `xorm:"varchar(100) notnull pk" json:"name"`
+	CreatedTime string `xorm:"varchar(100)" json:"createdTime"`
+	DisplayName string `xorm:"varchar(100)" json:"displayName"`
+
+	Provider string `xorm:"varchar(100)" json:"provider"`
+	Type     string `xorm:"varchar(100)" json:"type"`
+
+	ProductName        string  `xorm:"varchar(100)" json:"productName"`
+	ProductDisplayName string  `xorm:"varchar(100)" json:"productDisplayName"`
+	Detail             string  `xorm:"varchar(255)" json:"detail"`
+	Tag                string  `xorm:"varchar(100)" json:"tag"`
+	Currency           string  `xorm:"varchar(100)" json:"currency"`
+	Price              float64 `json:"price"`
+	ReturnUrl          string  `xorm:"varchar(1000)" json:"returnUrl"`
+
+	User         string `xorm:"varchar(100)" json:"user"`
+	PersonName   string `xorm:"varchar(100)" json:"personName"`
+	PersonIdCard string `xorm:"varchar(100)" json:"personIdCard"`
+	PersonEmail  string `xorm:"varchar(100)" json:"personEmail"`
+	PersonPhone  string `xorm:"varchar(100)" json:"personPhone"`
+
+	InvoiceType   string `xorm:"varchar(100)" json:"invoiceType"`
+	InvoiceTitle  string `xorm:"varchar(100)" json:"invoiceTitle"`
+	InvoiceTaxId  string `xorm:"varchar(100)" json:"invoiceTaxId"`
+	InvoiceRemark string `xorm:"varchar(100)" json:"invoiceRemark"`
+	InvoiceUrl    string `xorm:"varchar(255)" json:"invoiceUrl"`
+
+	OutOrderId string `xorm:"varchar(100)" json:"outOrderId"`
+	PayUrl     string `xorm:"varchar(2000)" json:"payUrl"`
+	State      string `xorm:"varchar(100)" json:"state"`
+	Message    string `xorm:"varchar(2000)" json:"message"`
+}
+
+func (c *Client) GetPayments() ([]*Payment, error) {
+	return c.GetPaymentsWithContext(context.Background())
+}
+
+func (c *Client) GetPaymentsWithContext(ctx context.Context) ([]*Payment, error) {
+	authConfig := c.getAuthConfig()
+
+	queryMap := map[string]string{
+		"owner": authConfig.OrganizationName,
+	}
+
+	url := c.GetUrl("get-payments", queryMap)
+
+	bytes, err := c.DoGetBytesWithContext(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	var payments []*Payment
+	err = c.unmarshal(bytes, &payments)
+	if err != nil {
+		return nil, err
+	}
+	return payments, nil
+}
+
+func (c *Client) GetPaginationPayments(p int, pageSize int, queryMap map[string]string) ([]*Payment, int, error) {
+	return c.GetPaginationPaymentsWithContext(context.Background(), p, pageSize, queryMap)
+}
+
+func (c *Client) GetPaginationPaymentsWithContext(ctx context.Context, p int, pageSize int, queryMap map[string]string) ([]*Payment, int, error) {
+	authConfig := c.getAuthConfig()
+
+	queryMap = getPaginationQueryMap(authConfig.OrganizationName, p, pageSize, queryMap)
+
+	url := c.GetUrl("get-payments", queryMap)
+
+	response, err := c.DoGetResponseWithContext(ctx, url)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	bytes, err := json.Marshal(response.Data)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var payments []*Payment
+	err = c.unmarshal(bytes, &payments)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	count, err := getCount(response)
+	if err != nil {
+		return nil, 0, err
+	}
+	return payments, count, nil
+}
+
+// GetUserPayments returns the payments of the user named userName.
+func (c *Client) GetUserPayments(userName string) ([]*Payment, error) {
+	return c.GetUserPaymentsWithContext(context.Background(), userName)
+}
+
+func (c *Client) GetUserPaymentsWithContext(ctx context.Context, userName string) ([]*Payment, error) {
+	authConfig := c.getAuthConfig()
+
+	queryMap := map[string]string{
+		"owner":        authConfig.OrganizationName,
+		"organization": authConfig.OrganizationName,
+		"user":         userName,
+	}
+
+	url := c.GetUrl("get-user-payments", queryMap)
+
+	bytes, err := c.DoGetBytesWithContext(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	var payments []*Payment
+	err = c.unmarshal(bytes, &payments)
+	if err != nil {
+		return nil, err
+	}
+	return payments, nil
+}
+
+func (c *Client) GetPayment(name string) (*Payment, error) {
+	return c.GetPaymentWithContext(context.Background(), name)
+}
+
+func (c *Client) GetPaymentWithContext(ctx context.Context, name string) (*Payment, error) {
+	authConfig := c.getAuthConfig()
+
+	queryMap := map[string]string{
+		"id": fmt.Sprintf("%s/%s", authConfig.OrganizationName, name),
+	}
+
+	url := c.GetUrl("get-payment", queryMap)
+
+	bytes, err := c.DoGetBytesWithContext(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	var payment *Payment
+	err = c.unmarshal(bytes, &payment)
+	if err != nil {
+		return nil, err
+	}
+	return payment, nil
+}
+
+func (c *Client) UpdatePayment(payment *Payment) (bool, error) {
+	return c.UpdatePaymentWithContext(context.Background(), payment)
+}
+
+func (c *Client) UpdatePaymentWithContext(ctx context.Context, payment *Payment) (bool, error) {
+	_, affected, err := c.modifyPayment(ctx, "update-payment", payment, nil)
+	return affected, err
+}
+
+func (c *Client) AddPayment(payment *Payment) (bool, error) {
+	return c.AddPaymentWithContext(context.Background(), payment)
+}
+
+func (c *Client) AddPaymentWithContext(ctx context.Context, payment *Payment) (bool, error) {
+	_, affected, err := c.modifyPayment(ctx, "add-payment", payment, nil)
+	return affected, err
+}
+
+func (c *Client) DeletePayment(payment *Payment) (bool, error) {
+	return c.DeletePaymentWithContext(context.Background(), payment)
+}
+
+func (c *Client) DeletePaymentWithContext(ctx context.Context, payment *Payment) (bool, error) {
+	_, affected, err := c.modifyPayment(ctx, "delete-payment", payment, nil)
+	return affected, err
+}
+
+// NotifyPayment asks the server to check the state of payment with its payment provider and update it.
+func (c *Client) NotifyPayment(payment *Payment) (bool, error) {
+	return c.NotifyPaymentWithContext(context.Background(), payment)
+}
+
+func (c *Client) NotifyPaymentWithContext(ctx context.Context, payment *Payment) (bool, error) {
+	_, affected, err := c.modifyPayment(ctx, "notify-payment", payment, nil)
+	return affected, err
+}
+
+// InvoicePayment issues the invoice of payment, with the invoice information it carries, and returns its url.
+func (c *Client) InvoicePayment(payment *Payment) (string, error) {
+	return c.InvoicePaymentWithContext(context.Background(), payment)
+}
+
+func (c *Client) InvoicePaymentWithContext(ctx context.Context, payment *Payment) (string, error) {
+	resp, _, err := c.modifyPayment(ctx, "invoice-payment", payment, nil)
+	if err != nil {
+		return "", err
+	}
+
+	invoiceUrl, ok := resp.Data.(string)
+	if !ok {
+		return "", fmt.Errorf("invalid invoice url: %v", resp.Data)
+	}
+	return invoiceUrl, nil
+}
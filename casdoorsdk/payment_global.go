@@ -0,0 +1,89 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import "context"
+
+func GetPayments() ([]*Payment, error) {
+	return globalClient.GetPayments()
+}
+
+func GetPaymentsWithContext(ctx context.Context) ([]*Payment, error) {
+	return globalClient.GetPaymentsWithContext(ctx)
+}
+
+func GetPaginationPayments(p int, pageSize int, queryMap map[string]string) ([]*Payment, int, error) {
+	return globalClient.GetPaginationPayments(p, pageSize, queryMap)
+}
+
+func GetPaginationPaymentsWithContext(ctx context.Context, p int, pageSize int, queryMap map[string]string) ([]*Payment, int, error) {
+	return globalClient.GetPaginationPaymentsWithContext(ctx, p, pageSize, queryMap)
+}
+
+func GetUserPayments(userName string) ([]*Payment, error) {
+	return globalClient.GetUserPayments(userName)
+}
+
+func GetUserPaymentsWithContext(ctx context.Context, userName string) ([]*Payment, error) {
+	return globalClient.GetUserPaymentsWithContext(ctx, userName)
+}
+
+func GetPayment(name string) (*Payment, error) {
+	return globalClient.GetPayment(name)
+}
+
+func GetPaymentWithContext(ctx context.Context, name string) (*Payment, error) {
+	return globalClient.GetPaymentWithContext(ctx, name)
+}
+
+func UpdatePayment(payment *Payment) (bool, error) {
+	return globalClient.UpdatePayment(payment)
+}
+
+func UpdatePaymentWithContext(ctx context.Context, payment *Payment) (bool, error) {
+	return globalClient.UpdatePaymentWithContext(ctx, payment)
+}
+
+func AddPayment(payment *Payment) (bool, error) {
+	return globalClient.AddPayment(payment)
+}
+
+func AddPaymentWithContext(ctx context.Context, payment *Payment) (bool, error) {
+	return globalClient.AddPaymentWithContext(ctx, payment)
+}
+
+func DeletePayment(payment *Payment) (bool, error) {
+	return globalClient.DeletePayment(payment)
+}
+
+func DeletePaymentWithContext(ctx context.Context, payment *Payment) (bool, error) {
+	return globalClient.DeletePaymentWithContext(ctx, payment)
+}
+
+func NotifyPayment(payment *Payment) (bool, error) {
+	return globalClient.NotifyPayment(payment)
+}
+
+func NotifyPaymentWithContext(ctx context.Context, payment *Payment) (bool, error) {
+	return globalClient.NotifyPaymentWithContext(ctx, payment)
+}
+
+func InvoicePayment(payment *Payment) (string, error) {
+	return globalClient.InvoicePayment(payment)
+}
+
+func InvoicePaymentWithContext(ctx context.Context, payment *Payment) (string, error) {
+	return globalClient.InvoicePaymentWithContext(ctx, payment)
+}
@@ -15,9 +15,9 @@
 package casdoorsdk
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
-	"strconv"
 )
 
 type Permission struct {
@@ -42,113 +42,174 @@ type Permission struct {
 	Approver    string `xorm:"varchar(100)" json:"approver"`
 	ApproveTime string `xorm:"varchar(100)" json:"approveTime"`
 	State       string `xorm:"varchar(100)" json:"state"`
+
+	unknownFields map[string]json.RawMessage
+}
+
+func (c *Client) GetPermissions() ([]*Permission, error) {
+	return c.GetPermissionsWithContext(context.Background())
 }
 
-func GetPermissions() ([]*Permission, error) {
+func (c *Client) GetPermissionsWithContext(ctx context.Context) ([]*Permission, error) {
+	authConfig := c.getAuthConfig()
+
 	queryMap := map[string]string{
 		"owner": authConfig.OrganizationName,
 	}
 
-	url := GetUrl("get-permissions", queryMap)
+	url := c.GetUrl("get-permissions", queryMap)
 
-	bytes, err := DoGetBytesRaw(url)
+	bytes, err := c.DoGetBytesRawWithContext(ctx, url)
 	if err != nil {
 		return nil, err
 	}
 
 	var permissions []*Permission
-	err = json.Unmarshal(bytes, &permissions)
+	err = c.unmarshal(bytes, &permissions)
 	if err != nil {
 		return nil, err
 	}
 	return permissions, nil
 }
 
-func GetPermissionsByRole(name string) ([]*Permission, error) {
+func (c *Client) GetPermissionsByRole(name string) ([]*Permission, error) {
+	return c.GetPermissionsByRoleWithContext(context.Background(), name)
+}
+
+func (c *Client) GetPermissionsByRoleWithContext(ctx context.Context, name string) ([]*Permission, error) {
+	authConfig := c.getAuthConfig()
+
 	queryMap := map[string]string{
 		"id": fmt.Sprintf("%s/%s", authConfig.OrganizationName, name),
 	}
 
-	url := GetUrl("get-permissions-by-role", queryMap)
+	url := c.GetUrl("get-permissions-by-role", queryMap)
 
-	bytes, err := DoGetBytes(url)
+	bytes, err := c.DoGetBytesWithContext(ctx, url)
 	if err != nil {
 		return nil, err
 	}
 
 	var permissions []*Permission
-	err = json.Unmarshal(bytes, &permissions)
+	err = c.unmarshal(bytes, &permissions)
 	if err != nil {
 		return nil, err
 	}
 	return permissions, nil
 }
 
-func GetPaginationPermissions(p int, pageSize int, queryMap map[string]string) ([]*Permission, int, error) {
-	queryMap["owner"] = authConfig.OrganizationName
-	queryMap["p"] = strconv.Itoa(p)
-	queryMap["pageSize"] = strconv.Itoa(pageSize)
+func (c *Client) GetPaginationPermissions(p int, pageSize int, queryMap map[string]string) ([]*Permission, int, error) {
+	return c.GetPaginationPermissionsWithContext(context.Background(), p, pageSize, queryMap)
+}
+
+func (c *Client) GetPaginationPermissionsWithContext(ctx context.Context, p int, pageSize int, queryMap map[string]string) ([]*Permission, int, error) {
+	authConfig := c.getAuthConfig()
 
-	url := GetUrl("get-permissions", queryMap)
+	queryMap = getPaginationQueryMap(authConfig.OrganizationName, p, pageSize, queryMap)
 
-	response, err := DoGetResponse(url)
+	url := c.GetUrl("get-permissions", queryMap)
+
+	response, err := c.DoGetResponseWithContext(ctx, url)
 	if err != nil {
 		return nil, 0, err
 	}
 
-	if response.Status != "ok" {
-		return nil, 0, fmt.Errorf(response.Msg)
-	}
-
 	bytes, err := json.Marshal(response.Data)
 	if err != nil {
 		return nil, 0, err
 	}
 
 	var permissions []*Permission
-	err = json.Unmarshal(bytes, &permissions)
+	err = c.unmarshal(bytes, &permissions)
 	if err != nil {
 		return nil, 0, err
 	}
-	return permissions, int(response.Data2.(float64)), nil
+
+	count, err := getCount(response)
+	if err != nil {
+		return nil, 0, err
+	}
+	return permissions, count, nil
+}
+
+func (c *Client) GetPermission(name string) (*Permission, error) {
+	return c.GetPermissionWithContext(context.Background(), name)
 }
 
-func GetPermission(name string) (*Permission, error) {
+func (c *Client) GetPermissionWithContext(ctx context.Context, name string) (*Permission, error) {
+	authConfig := c.getAuthConfig()
+
 	queryMap := map[string]string{
 		"id": fmt.Sprintf("%s/%s", authConfig.OrganizationName, name),
 	}
 
-	url := GetUrl("get-permission", queryMap)
+	url := c.GetUrl("get-permission", queryMap)
 
-	bytes, err := DoGetBytesRaw(url)
+	bytes, err := c.DoGetBytesRawWithContext(ctx, url)
 	if err != nil {
 		return nil, err
 	}
 
 	var permission *Permission
-	err = json.Unmarshal(bytes, &permission)
+	err = c.unmarshal(bytes, &permission)
 	if err != nil {
 		return nil, err
 	}
 	return permission, nil
 }
 
-func UpdatePermission(permission *Permission) (bool, error) {
-	_, affected, err := modifyPermission("update-permission", permission, nil)
+func (c *Client) UpdatePermission(permission *Permission) (bool, error) {
+	return c.UpdatePermissionWithContext(context.Background(), permission)
+}
+
+func (c *Client) UpdatePermissionWithContext(ctx context.Context, permission *Permission) (bool, error) {
+	_, affected, err := c.modifyPermission(ctx, "update-permission", permission, nil)
 	return affected, err
 }
 
-func UpdatePermissionForColumns(permission *Permission, columns []string) (bool, error) {
-	_, affected, err := modifyPermission("update-permission", permission, columns)
+func (c *Client) UpdatePermissionForColumns(permission *Permission, columns []string) (bool, error) {
+	return c.UpdatePermissionForColumnsWithContext(context.Background(), permission, columns)
+}
+
+func (c *Client) UpdatePermissionForColumnsWithContext(ctx context.Context, permission *Permission, columns []string) (bool, error) {
+	_, affected, err := c.modifyPermission(ctx, "update-permission", permission, columns)
 	return affected, err
 }
 
-func AddPermission(permission *Permission) (bool, error) {
-	_, affected, err := modifyPermission("add-permission", permission, nil)
+func (c *Client) AddPermission(permission *Permission) (bool, error) {
+	return c.AddPermissionWithContext(context.Background(), permission)
+}
+
+func (c *Client) AddPermissionWithContext(ctx context.Context, permission *Permission) (bool, error) {
+	_, affected, err := c.modifyPermission(ctx, "add-permission", permission, nil)
 	return affected, err
 }
 
-func DeletePermission(permission *Permission) (bool, error) {
-	_, affected, err := modifyPermission("delete-permission", permission, nil)
+func (c *Client) DeletePermission(permission *Permission) (bool, error) {
+	return c.DeletePermissionWithContext(context.Background(), permission)
+}
+
+func (c *Client) DeletePermissionWithContext(ctx context.Context, permission *Permission) (bool, error) {
+	_, affected, err := c.modifyPermission(ctx, "delete-permission", permission, nil)
 	return affected, err
 }
+
+func (p *Permission) UnmarshalJSON(data []byte) error {
+	type permission Permission
+	unknownFields, err := unmarshalWithUnknownFields(data, (*permission)(p))
+	if err != nil {
+		return err
+	}
+
+	p.unknownFields = unknownFields
+	return nil
+}
+
+func (p Permission) getUnknownFields() map[string]json.RawMessage {
+	return p.unknownFields
+}
+
+func (p Permission) MarshalJSON() ([]byte, error) {
+	type permission Permission
+	return marshalWithUnknownFields(p.unknownFields, permission(p))
+}
@@ -0,0 +1,57 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"net/url"
+
+	"golang.org/x/oauth2"
+)
+
+// GenerateCodeVerifier returns a random PKCE code verifier (RFC 7636), to keep until the code is exchanged
+// with GetOAuthTokenWithPKCE, e.g. in the session of the user.
+func GenerateCodeVerifier() (string, error) {
+	b := make([]byte, 32)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// GenerateCodeChallenge returns the S256 code challenge of verifier, to send with the authorization request.
+func GenerateCodeChallenge(verifier string) string {
+	hash := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(hash[:])
+}
+
+// GetSigninUrlWithPKCE is like GetSigninUrl, with the S256 code challenge of the PKCE flow.
+func (c *Client) GetSigninUrlWithPKCE(redirectUri string, codeChallenge string) string {
+	return c.GetSigninUrl(redirectUri) + "&code_challenge=" + url.QueryEscape(codeChallenge) + "&code_challenge_method=S256"
+}
+
+// GetOAuthTokenWithPKCE exchanges a code obtained with GetSigninUrlWithPKCE for a token, proving it with verifier.
+// Public clients, which can't keep a client secret, may leave ClientSecret empty.
+func (c *Client) GetOAuthTokenWithPKCE(code string, verifier string) (*oauth2.Token, error) {
+	return c.GetOAuthTokenWithPKCEWithContext(context.Background(), code, verifier)
+}
+
+func (c *Client) GetOAuthTokenWithPKCEWithContext(ctx context.Context, code string, verifier string) (*oauth2.Token, error) {
+	return c.exchangeCode(ctx, code, oauth2.SetAuthURLParam("code_verifier", verifier))
+}
@@ -0,0 +1,151 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// Plan has the same definition as https://github.com/casdoor/casdoor/blob/master/object/plan.go#L24
+type Plan struct {
+	Owner       string `xorm:"varchar(100) notnull pk" json:"owner"`
+	Name        string `xorm:"varchar(100) notnull pk" json:"name"`
+	CreatedTime string `xorm:"varchar(100)" json:"createdTime"`
+	DisplayName string `xorm:"varchar(100)" json:"displayName"`
+	Description string `xorm:"varchar(100)" json:"description"`
+
+	PricePerMonth float64 `json:"pricePerMonth"`
+	PricePerYear  float64 `json:"pricePerYear"`
+	Currency      string  `xorm:"varchar(100)" json:"currency"`
+	IsEnabled     bool    `json:"isEnabled"`
+
+	Role    string   `xorm:"varchar(100)" json:"role"`
+	Options []string `xorm:"-" json:"options"`
+}
+
+func (c *Client) GetPlans() ([]*Plan, error) {
+	return c.GetPlansWithContext(context.Background())
+}
+
+func (c *Client) GetPlansWithContext(ctx context.Context) ([]*Plan, error) {
+	authConfig := c.getAuthConfig()
+
+	queryMap := map[string]string{
+		"owner": authConfig.OrganizationName,
+	}
+
+	url := c.GetUrl("get-plans", queryMap)
+
+	bytes, err := c.DoGetBytesWithContext(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	var plans []*Plan
+	err = c.unmarshal(bytes, &plans)
+	if err != nil {
+		return nil, err
+	}
+	return plans, nil
+}
+
+func (c *Client) GetPaginationPlans(p int, pageSize int, queryMap map[string]string) ([]*Plan, int, error) {
+	return c.GetPaginationPlansWithContext(context.Background(), p, pageSize, queryMap)
+}
+
+func (c *Client) GetPaginationPlansWithContext(ctx context.Context, p int, pageSize int, queryMap map[string]string) ([]*Plan, int, error) {
+	authConfig := c.getAuthConfig()
+
+	queryMap = getPaginationQueryMap(authConfig.OrganizationName, p, pageSize, queryMap)
+
+	url := c.GetUrl("get-plans", queryMap)
+
+	response, err := c.DoGetResponseWithContext(ctx, url)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	bytes, err := json.Marshal(response.Data)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var plans []*Plan
+	err = c.unmarshal(bytes, &plans)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	count, err := getCount(response)
+	if err != nil {
+		return nil, 0, err
+	}
+	return plans, count, nil
+}
+
+func (c *Client) GetPlan(name string) (*Plan, error) {
+	return c.GetPlanWithContext(context.Background(), name)
+}
+
+func (c *Client) GetPlanWithContext(ctx context.Context, name string) (*Plan, error) {
+	authConfig := c.getAuthConfig()
+
+	queryMap := map[string]string{
+		"id": fmt.Sprintf("%s/%s", authConfig.OrganizationName, name),
+	}
+
+	url := c.GetUrl("get-plan", queryMap)
+
+	bytes, err := c.DoGetBytesWithContext(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	var plan *Plan
+	err = c.unmarshal(bytes, &plan)
+	if err != nil {
+		return nil, err
+	}
+	return plan, nil
+}
+
+func (c *Client) UpdatePlan(plan *Plan) (bool, error) {
+	return c.UpdatePlanWithContext(context.Background(), plan)
+}
+
+func (c *Client) UpdatePlanWithContext(ctx context.Context, plan *Plan) (bool, error) {
+	_, affected, err := c.modifyPlan(ctx, "update-plan", plan, nil)
+	return affected, err
+}
+
+func (c *Client) AddPlan(plan *Plan) (bool, error) {
+	return c.AddPlanWithContext(context.Background(), plan)
+}
+
+func (c *Client) AddPlanWithContext(ctx context.Context, plan *Plan) (bool, error) {
+	_, affected, err := c.modifyPlan(ctx, "add-plan", plan, nil)
+	return affected, err
+}
+
+func (c *Client) DeletePlan(plan *Plan) (bool, error) {
+	return c.DeletePlanWithContext(context.Background(), plan)
+}
+
+func (c *Client) DeletePlanWithContext(ctx context.Context, plan *Plan) (bool, error) {
+	_, affected, err := c.modifyPlan(ctx, "delete-plan", plan, nil)
+	return affected, err
+}
@@ -0,0 +1,65 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import "context"
+
+func GetPlans() ([]*Plan, error) {
+	return globalClient.GetPlans()
+}
+
+func GetPlansWithContext(ctx context.Context) ([]*Plan, error) {
+	return globalClient.GetPlansWithContext(ctx)
+}
+
+func GetPaginationPlans(p int, pageSize int, queryMap map[string]string) ([]*Plan, int, error) {
+	return globalClient.GetPaginationPlans(p, pageSize, queryMap)
+}
+
+func GetPaginationPlansWithContext(ctx context.Context, p int, pageSize int, queryMap map[string]string) ([]*Plan, int, error) {
+	return globalClient.GetPaginationPlansWithContext(ctx, p, pageSize, queryMap)
+}
+
+func GetPlan(name string) (*Plan, error) {
+	return globalClient.GetPlan(name)
+}
+
+func GetPlanWithContext(ctx context.Context, name string) (*Plan, error) {
+	return globalClient.GetPlanWithContext(ctx, name)
+}
+
+func UpdatePlan(plan *Plan) (bool, error) {
+	return globalClient.UpdatePlan(plan)
+}
+
+func UpdatePlanWithContext(ctx context.Context, plan *Plan) (bool, error) {
+	return globalClient.UpdatePlanWithContext(ctx, plan)
+}
+
+func AddPlan(plan *Plan) (bool, error) {
+	return globalClient.AddPlan(plan)
+}
+
+func AddPlanWithContext(ctx context.Context, plan *Plan) (bool, error) {
+	return globalClient.AddPlanWithContext(ctx, plan)
+}
+
+func DeletePlan(plan *Plan) (bool, error) {
+	return globalClient.DeletePlan(plan)
+}
+
+func DeletePlanWithContext(ctx context.Context, plan *Plan) (bool, error) {
+	return globalClient.DeletePlanWithContext(ctx, plan)
+}
@@ -0,0 +1,154 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// Pricing has the same definition as https://github.com/casdoor/casdoor/blob/master/object/pricing.go#L25
+type Pricing struct {
+	Owner       string `xorm:"varchar(100) notnull pk" json:"owner"`
+	Name        string `xorm:"varchar(100) notnull pk" json:"name"`
+	CreatedTime string `xorm:"varchar(100)" json:"createdTime"`
+	DisplayName string `xorm:"varchar(100)" json:"displayName"`
+	Description string `xorm:"varchar(100)" json:"description"`
+
+	Plans         []string `xorm:"mediumtext" json:"plans"`
+	IsEnabled     bool     `json:"isEnabled"`
+	TrialDuration int      `json:"trialDuration"`
+	Application   string   `xorm:"varchar(100)" json:"application"`
+
+	Submitter   string `xorm:"varchar(100)" json:"submitter"`
+	Approver    string `xorm:"varchar(100)" json:"approver"`
+	ApproveTime string `xorm:"varchar(100)" json:"approveTime"`
+
+	State string `xorm:"varchar(100)" json:"state"`
+}
+
+func (c *Client) GetPricings() ([]*Pricing, error) {
+	return c.GetPricingsWithContext(context.Background())
+}
+
+func (c *Client) GetPricingsWithContext(ctx context.Context) ([]*Pricing, error) {
+	authConfig := c.getAuthConfig()
+
+	queryMap := map[string]string{
+		"owner": authConfig.OrganizationName,
+	}
+
+	url := c.GetUrl("get-pricings", queryMap)
+
+	bytes, err := c.DoGetBytesWithContext(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	var pricings []*Pricing
+	err = c.unmarshal(bytes, &pricings)
+	if err != nil {
+		return nil, err
+	}
+	return pricings, nil
+}
+
+func (c *Client) GetPaginationPricings(p int, pageSize int, queryMap map[string]string) ([]*Pricing, int, error) {
+	return c.GetPaginationPricingsWithContext(context.Background(), p, pageSize, queryMap)
+}
+
+func (c *Client) GetPaginationPricingsWithContext(ctx context.Context, p int, pageSize int, queryMap map[string]string) ([]*Pricing, int, error) {
+	authConfig := c.getAuthConfig()
+
+	queryMap = getPaginationQueryMap(authConfig.OrganizationName, p, pageSize, queryMap)
+
+	url := c.GetUrl("get-pricings", queryMap)
+
+	response, err := c.DoGetResponseWithContext(ctx, url)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	bytes, err := json.Marshal(response.Data)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var pricings []*Pricing
+	err = c.unmarshal(bytes, &pricings)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	count, err := getCount(response)
+	if err != nil {
+		return nil, 0, err
+	}
+	return pricings, count, nil
+}
+
+func (c *Client) GetPricing(name string) (*Pricing, error) {
+	return c.GetPricingWithContext(context.Background(), name)
+}
+
+func (c *Client) GetPricingWithContext(ctx context.Context, name string) (*Pricing, error) {
+	authConfig := c.getAuthConfig()
+
+	queryMap := map[string]string{
+		"id": fmt.Sprintf("%s/%s", authConfig.OrganizationName, name),
+	}
+
+	url := c.GetUrl("get-pricing", queryMap)
+
+	bytes, err := c.DoGetBytesWithContext(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	var pricing *Pricing
+	err = c.unmarshal(bytes, &pricing)
+	if err != nil {
+		return nil, err
+	}
+	return pricing, nil
+}
+
+func (c *Client) UpdatePricing(pricing *Pricing) (bool, error) {
+	return c.UpdatePricingWithContext(context.Background(), pricing)
+}
+
+func (c *Client) UpdatePricingWithContext(ctx context.Context, pricing *Pricing) (bool, error) {
+	_, affected, err := c.modifyPricing(ctx, "update-pricing", pricing, nil)
+	return affected, err
+}
+
+func (c *Client) AddPricing(pricing *Pricing) (bool, error) {
+	return c.AddPricingWithContext(context.Background(), pricing)
+}
+
+func (c *Client) AddPricingWithContext(ctx context.Context, pricing *Pricing) (bool, error) {
+	_, affected, err := c.modifyPricing(ctx, "add-pricing", pricing, nil)
+	return affected, err
+}
+
+func (c *Client) DeletePricing(pricing *Pricing) (bool, error) {
+	return c.DeletePricingWithContext(context.Background(), pricing)
+}
+
+func (c *Client) DeletePricingWithContext(ctx context.Context, pricing *Pricing) (bool, error) {
+	_, affected, err := c.modifyPricing(ctx, "delete-pricing", pricing, nil)
+	return affected, err
+}
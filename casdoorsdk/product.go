@@ -0,0 +1,183 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// Product has the same definition as https://github.com/casdoor/casdoor/blob/master/object/product.go#L25
+type Product struct {
+	Owner       string `xorm:"varchar(100) notnull pk" json:"owner"`
+	Name        string `xorm:"varchar(100) notnull pk" json:"name"`
+	CreatedTime string `xorm:"varchar(100)" json:"createdTime"`
+	DisplayName string `xorm:"varchar(100)" json:"displayName"`
+
+	Image       string   `xorm:"varchar(100)" json:"image"`
+	Detail      string   `xorm:"varchar(255)" json:"detail"`
+	Description string   `xorm:"varchar(100)" json:"description"`
+	Tag         string   `xorm:"varchar(100)" json:"tag"`
+	Currency    string   `xorm:"varchar(100)" json:"currency"`
+	Price       float64  `json:"price"`
+	Quantity    int      `json:"quantity"`
+	Sold        int      `json:"sold"`
+	Providers   []string `xorm:"varchar(100)" json:"providers"`
+	ReturnUrl   string   `xorm:"varchar(1000)" json:"returnUrl"`
+
+	State string `xorm:"varchar(100)" json:"state"`
+
+	ProviderObjs []*Provider `xorm:"extends" json:"providerObjs"`
+}
+
+func (c *Client) GetProducts() ([]*Product, error) {
+	return c.GetProductsWithContext(context.Background())
+}
+
+func (c *Client) GetProductsWithContext(ctx context.Context) ([]*Product, error) {
+	authConfig := c.getAuthConfig()
+
+	queryMap := map[string]string{
+		"owner": authConfig.OrganizationName,
+	}
+
+	url := c.GetUrl("get-products", queryMap)
+
+	bytes, err := c.DoGetBytesWithContext(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	var products []*Product
+	err = c.unmarshal(bytes, &products)
+	if err != nil {
+		return nil, err
+	}
+	return products, nil
+}
+
+func (c *Client) GetPaginationProducts(p int, pageSize int, queryMap map[string]string) ([]*Product, int, error) {
+	return c.GetPaginationProductsWithContext(context.Background(), p, pageSize, queryMap)
+}
+
+func (c *Client) GetPaginationProductsWithContext(ctx context.Context, p int, pageSize int, queryMap map[string]string) ([]*Product, int, error) {
+	authConfig := c.getAuthConfig()
+
+	queryMap = getPaginationQueryMap(authConfig.OrganizationName, p, pageSize, queryMap)
+
+	url := c.GetUrl("get-products", queryMap)
+
+	response, err := c.DoGetResponseWithContext(ctx, url)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	bytes, err := json.Marshal(response.Data)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var products []*Product
+	err = c.unmarshal(bytes, &products)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	count, err := getCount(response)
+	if err != nil {
+		return nil, 0, err
+	}
+	return products, count, nil
+}
+
+func (c *Client) GetProduct(name string) (*Product, error) {
+	return c.GetProductWithContext(context.Background(), name)
+}
+
+func (c *Client) GetProductWithContext(ctx context.Context, name string) (*Product, error) {
+	authConfig := c.getAuthConfig()
+
+	queryMap := map[string]string{
+		"id": fmt.Sprintf("%s/%s", authConfig.OrganizationName, name),
+	}
+
+	url := c.GetUrl("get-product", queryMap)
+
+	bytes, err := c.DoGetBytesWithContext(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	var product *Product
+	err = c.unmarshal(bytes, &product)
+	if err != nil {
+		return nil, err
+	}
+	return product, nil
+}
+
+func (c *Client) UpdateProduct(product *Product) (bool, error) {
+	return c.UpdateProductWithContext(context.Background(), product)
+}
+
+func (c *Client) UpdateProductWithContext(ctx context.Context, product *Product) (bool, error) {
+	_, affected, err := c.modifyProduct(ctx, "update-product", product, nil)
+	return affected, err
+}
+
+func (c *Client) AddProduct(product *Product) (bool, error) {
+	return c.AddProductWithContext(context.Background(), product)
+}
+
+func (c *Client) AddProductWithContext(ctx context.Context, product *Product) (bool, error) {
+	_, affected, err := c.modifyProduct(ctx, "add-product", product, nil)
+	return affected, err
+}
+
+func (c *Client) DeleteProduct(product *Product) (bool, error) {
+	return c.DeleteProductWithContext(context.Background(), product)
+}
+
+func (c *Client) DeleteProductWithContext(ctx context.Context, product *Product) (bool, error) {
+	_, affected, err := c.modifyProduct(ctx, "delete-product", product, nil)
+	return affected, err
+}
+
+// BuyProduct buys the product named name with the payment provider named providerName,
+// and returns the url of the page to pay at.
+func (c *Client) BuyProduct(name string, providerName string) (string, error) {
+	return c.BuyProductWithContext(context.Background(), name, providerName)
+}
+
+func (c *Client) BuyProductWithContext(ctx context.Context, name string, providerName string) (string, error) {
+	authConfig := c.getAuthConfig()
+
+	queryMap := map[string]string{
+		"id":           fmt.Sprintf("%s/%s", authConfig.OrganizationName, name),
+		"providerName": providerName,
+	}
+
+	resp, err := c.DoPostWithContext(ctx, "buy-product", queryMap, nil, false, false)
+	if err != nil {
+		return "", err
+	}
+
+	payUrl, ok := resp.Data.(string)
+	if !ok {
+		return "", fmt.Errorf("invalid pay url: %v", resp.Data)
+	}
+	return payUrl, nil
+}
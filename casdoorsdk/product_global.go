@@ -0,0 +1,73 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import "context"
+
+func GetProducts() ([]*Product, error) {
+	return globalClient.GetProducts()
+}
+
+func GetProductsWithContext(ctx context.Context) ([]*Product, error) {
+	return globalClient.GetProductsWithContext(ctx)
+}
+
+func GetPaginationProducts(p int, pageSize int, queryMap map[string]string) ([]*Product, int, error) {
+	return globalClient.GetPaginationProducts(p, pageSize, queryMap)
+}
+
+func GetPaginationProductsWithContext(ctx context.Context, p int, pageSize int, queryMap map[string]string) ([]*Product, int, error) {
+	return globalClient.GetPaginationProductsWithContext(ctx, p, pageSize, queryMap)
+}
+
+func GetProduct(name string) (*Product, error) {
+	return globalClient.GetProduct(name)
+}
+
+func GetProductWithContext(ctx context.Context, name string) (*Product, error) {
+	return globalClient.GetProductWithContext(ctx, name)
+}
+
+func UpdateProduct(product *Product) (bool, error) {
+	return globalClient.UpdateProduct(product)
+}
+
+func UpdateProductWithContext(ctx context.Context, product *Product) (bool, error) {
+	return globalClient.UpdateProductWithContext(ctx, product)
+}
+
+func AddProduct(product *Product) (bool, error) {
+	return globalClient.AddProduct(product)
+}
+
+func AddProductWithContext(ctx context.Context, product *Product) (bool, error) {
+	return globalClient.AddProductWithContext(ctx, product)
+}
+
+func DeleteProduct(product *Product) (bool, error) {
+	return globalClient.DeleteProduct(product)
+}
+
+func DeleteProductWithContext(ctx context.Context, product *Product) (bool, error) {
+	return globalClient.DeleteProductWithContext(ctx, product)
+}
+
+func BuyProduct(name string, providerName string) (string, error) {
+	return globalClient.BuyProduct(name, providerName)
+}
+
+func BuyProductWithContext(ctx context.Context, name string, providerName string) (string, error) {
+	return globalClient.BuyProductWithContext(ctx, name, providerName)
+}
@@ -0,0 +1,121 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package promcasdoor exposes Prometheus metrics on the requests of the SDK to the Casdoor server: their count,
+// errors and latency by action, e.g. to alert when the Casdoor API slows down.
+//
+//	collector := promcasdoor.NewCollector()
+//	prometheus.MustRegister(collector)
+//	client.OnResponse(collector.Observe)
+package promcasdoor
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Collector collects the metrics of the requests it observes:
+//
+//   - casdoor_sdk_requests_total, the requests by action, method and HTTP status, "" if none was received,
+//   - casdoor_sdk_request_errors_total, the failed requests by action, with a transport error, an HTTP error status
+//     or an error returned by the server,
+//   - casdoor_sdk_request_duration_seconds, the latency of the requests by action.
+type Collector struct {
+	requests *prometheus.CounterVec
+	errors   *prometheus.CounterVec
+	duration *prometheus.HistogramVec
+}
+
+// NewCollector returns a Collector, to be registered to a Prometheus registry
+// and fed by the Observe hook of the clients.
+func NewCollector() *Collector {
+	return &Collector{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "casdoor_sdk_requests_total",
+			Help: "Number of requests sent to the Casdoor server.",
+		}, []string{"action", "method", "status"}),
+		errors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "casdoor_sdk_request_errors_total",
+			Help: "Number of requests sent to the Casdoor server which failed.",
+		}, []string{"action"}),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "casdoor_sdk_request_duration_seconds",
+			Help:    "Latency of the requests sent to the Casdoor server.",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"action"}),
+	}
+}
+
+// Describe implements prometheus.Collector.
+func (c *Collector) Describe(ch chan<- *prometheus.Desc) {
+	c.requests.Describe(ch)
+	c.errors.Describe(ch)
+	c.duration.Describe(ch)
+}
+
+// Collect implements prometheus.Collector.
+func (c *Collector) Collect(ch chan<- prometheus.Metric) {
+	c.requests.Collect(ch)
+	c.errors.Collect(ch)
+	c.duration.Collect(ch)
+}
+
+// Observe records a request. It is a casdoorsdk.ResponseHook, to be added with OnResponse.
+func (c *Collector) Observe(req *http.Request, resp *http.Response, err error, duration time.Duration) {
+	action := getAction(req.URL.Path)
+
+	status := ""
+	if resp != nil {
+		status = strconv.Itoa(resp.StatusCode)
+	}
+
+	c.requests.WithLabelValues(action, req.Method, status).Inc()
+	c.duration.WithLabelValues(action).Observe(duration.Seconds())
+	if err != nil || resp == nil || resp.StatusCode >= http.StatusBadRequest || isErrorResponse(resp) {
+		c.errors.WithLabelValues(action).Inc()
+	}
+}
+
+// isErrorResponse reports whether resp is a JSON response of the server with the "error" status.
+func isErrorResponse(resp *http.Response) bool {
+	if !strings.HasPrefix(resp.Header.Get("Content-Type"), "application/json") {
+		return false
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return false
+	}
+
+	var response struct {
+		Status string `json:"status"`
+	}
+	err = json.Unmarshal(body, &response)
+	return err == nil && response.Status == "error"
+}
+
+// getAction returns the API action of path, e.g. "get-user" for "/api/get-user".
+func getAction(path string) string {
+	i := strings.Index(path, "/api/")
+	if i == -1 {
+		return strings.TrimPrefix(path, "/")
+	}
+	return path[i+len("/api/"):]
+}
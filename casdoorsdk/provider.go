@@ -14,6 +14,13 @@
 
 package casdoorsdk
 
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// Provider has the same definition as https://github.com/casdoor/casdoor/blob/master/object/provider.go#L27
 type Provider struct {
 	Owner       string `xorm:"varchar(100) notnull pk" json:"owner"`
 	Name        string `xorm:"varchar(100) notnull pk" json:"name"`
@@ -59,3 +66,114 @@ type Provider struct {
 
 	ProviderUrl string `xorm:"varchar(200)" json:"providerUrl"`
 }
+
+func (c *Client) GetProviders() ([]*Provider, error) {
+	return c.GetProvidersWithContext(context.Background())
+}
+
+func (c *Client) GetProvidersWithContext(ctx context.Context) ([]*Provider, error) {
+	queryMap := map[string]string{
+		"owner": "admin",
+	}
+
+	url := c.GetUrl("get-providers", queryMap)
+
+	bytes, err := c.DoGetBytesWithContext(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	var providers []*Provider
+	err = c.unmarshal(bytes, &providers)
+	if err != nil {
+		return nil, err
+	}
+	return providers, nil
+}
+
+func (c *Client) GetPaginationProviders(p int, pageSize int, queryMap map[string]string) ([]*Provider, int, error) {
+	return c.GetPaginationProvidersWithContext(context.Background(), p, pageSize, queryMap)
+}
+
+func (c *Client) GetPaginationProvidersWithContext(ctx context.Context, p int, pageSize int, queryMap map[string]string) ([]*Provider, int, error) {
+	queryMap = getPaginationQueryMap("admin", p, pageSize, queryMap)
+
+	url := c.GetUrl("get-providers", queryMap)
+
+	response, err := c.DoGetResponseWithContext(ctx, url)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	bytes, err := json.Marshal(response.Data)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var providers []*Provider
+	err = c.unmarshal(bytes, &providers)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	count, err := getCount(response)
+	if err != nil {
+		return nil, 0, err
+	}
+	return providers, count, nil
+}
+
+func (c *Client) GetProvider(name string) (*Provider, error) {
+	return c.GetProviderWithContext(context.Background(), name)
+}
+
+func (c *Client) GetProviderWithContext(ctx context.Context, name string) (*Provider, error) {
+	queryMap := map[string]string{
+		"id": fmt.Sprintf("admin/%s", name),
+	}
+
+	url := c.GetUrl("get-provider", queryMap)
+
+	bytes, err := c.DoGetBytesWithContext(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	var provider *Provider
+	err = c.unmarshal(bytes, &provider)
+	if err != nil {
+		return nil, err
+	}
+	return provider, nil
+}
+
+func (c *Client) UpdateProvider(provider *Provider) (bool, error) {
+	return c.UpdateProviderWithContext(context.Background(), provider)
+}
+
+func (c *Client) UpdateProviderWithContext(ctx context.Context, provider *Provider) (bool, error) {
+	_, affected, err := c.modifyProvider(ctx, "update-provider", provider)
+	return affected, err
+}
+
+func (c *Client) AddProvider(provider *Provider) (bool, error) {
+	return c.AddProviderWithContext(context.Background(), provider)
+}
+
+func (c *Client) AddProviderWithContext(ctx context.Context, provider *Provider) (bool, error) {
+	_, affected, err := c.modifyProvider(ctx, "add-provider", provider)
+	return affected, err
+}
+
+func (c *Client) DeleteProvider(name string) (bool, error) {
+	return c.DeleteProviderWithContext(context.Background(), name)
+}
+
+func (c *Client) DeleteProviderWithContext(ctx context.Context, name string) (bool, error) {
+	provider := Provider{
+		Owner: "admin",
+		Name:  name,
+	}
+	_, affected, err := c.modifyProvider(ctx, "delete-provider", &provider)
+	return affected, err
+}
@@ -0,0 +1,65 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import "context"
+
+func GetProviders() ([]*Provider, error) {
+	return globalClient.GetProviders()
+}
+
+func GetProvidersWithContext(ctx context.Context) ([]*Provider, error) {
+	return globalClient.GetProvidersWithContext(ctx)
+}
+
+func GetPaginationProviders(p int, pageSize int, queryMap map[string]string) ([]*Provider, int, error) {
+	return globalClient.GetPaginationProviders(p, pageSize, queryMap)
+}
+
+func GetPaginationProvidersWithContext(ctx context.Context, p int, pageSize int, queryMap map[string]string) ([]*Provider, int, error) {
+	return globalClient.GetPaginationProvidersWithContext(ctx, p, pageSize, queryMap)
+}
+
+func GetProvider(name string) (*Provider, error) {
+	return globalClient.GetProvider(name)
+}
+
+func GetProviderWithContext(ctx context.Context, name string) (*Provider, error) {
+	return globalClient.GetProviderWithContext(ctx, name)
+}
+
+func UpdateProvider(provider *Provider) (bool, error) {
+	return globalClient.UpdateProvider(provider)
+}
+
+func UpdateProviderWithContext(ctx context.Context, provider *Provider) (bool, error) {
+	return globalClient.UpdateProviderWithContext(ctx, provider)
+}
+
+func AddProvider(provider *Provider) (bool, error) {
+	return globalClient.AddProvider(provider)
+}
+
+func AddProviderWithContext(ctx context.Context, provider *Provider) (bool, error) {
+	return globalClient.AddProviderWithContext(ctx, provider)
+}
+
+func DeleteProvider(name string) (bool, error) {
+	return globalClient.DeleteProvider(name)
+}
+
+func DeleteProviderWithContext(ctx context.Context, name string) (bool, error) {
+	return globalClient.DeleteProviderWithContext(ctx, name)
+}
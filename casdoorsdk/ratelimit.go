@@ -0,0 +1,151 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RateLimit is the rate limit reported by the server, or by a gateway in front of it, in the headers of a response.
+type RateLimit struct {
+	Action string
+	// Limit and Remaining are the number of requests allowed in the current window and left in it, or -1 if unknown.
+	Limit     int
+	Remaining int
+	// Reset is when the current window ends, or zero if unknown.
+	Reset time.Time
+	// RetryAfter is how long to wait before retrying a 429 or 503 response, or zero if not told.
+	RetryAfter time.Duration
+}
+
+// RateLimitHook is called with the rate limit of every response that reports one.
+type RateLimitHook func(rateLimit RateLimit)
+
+// SetRateLimitHook sets a hook to observe the rate limits of the server, e.g. to export them as metrics
+// and tune the concurrency of sync jobs. The hook is called synchronously and must be fast. nil removes it.
+func (c *Client) SetRateLimitHook(hook RateLimitHook) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.rateLimitHook = hook
+}
+
+func (c *Client) getRateLimitHook() RateLimitHook {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.rateLimitHook
+}
+
+// RateLimitError is returned, wrapped in a RequestError, when the server or a gateway in front of it answers
+// a request with 429 Too Many Requests. It is retryable: with SetRetryOptions, the request is sent again
+// after the delay asked by the Retry-After header, capped by RetryOptions.MaxDelay.
+type RateLimitError struct {
+	RateLimit
+}
+
+func (e *RateLimitError) Error() string {
+	if e.RetryAfter > 0 {
+		return fmt.Sprintf("rate limited by the server, retry after %s", e.RetryAfter)
+	}
+	return "rate limited by the server"
+}
+
+// GetRetryAfter returns how long the server asked to wait before retrying the request that failed with err,
+// from the Retry-After header of a 429 or 503 response.
+func GetRetryAfter(err error) (time.Duration, bool) {
+	var e *retryableError
+	if errors.As(err, &e) && e.retryAfter > 0 {
+		return e.retryAfter, true
+	}
+	return 0, false
+}
+
+// observeRateLimit calls the rate limit hook if resp reports a rate limit.
+func (c *Client) observeRateLimit(action string, resp *http.Response) {
+	hook := c.getRateLimitHook()
+	if hook == nil {
+		return
+	}
+
+	rateLimit, ok := parseRateLimit(resp.Header, time.Now())
+	if ok {
+		rateLimit.Action = action
+		hook(rateLimit)
+	}
+}
+
+// parseRateLimit parses the X-RateLimit-* or IETF RateLimit-* headers, and Retry-After.
+func parseRateLimit(header http.Header, now time.Time) (RateLimit, bool) {
+	rateLimit := RateLimit{
+		Limit:      parseHeaderInt(header, "X-RateLimit-Limit", "RateLimit-Limit"),
+		Remaining:  parseHeaderInt(header, "X-RateLimit-Remaining", "RateLimit-Remaining"),
+		RetryAfter: parseRetryAfter(header.Get("Retry-After"), now),
+	}
+
+	reset := parseHeaderInt(header, "X-RateLimit-Reset", "RateLimit-Reset")
+	if reset > 0 {
+		// X-RateLimit-Reset is usually a Unix time, while RateLimit-Reset is a number of seconds
+		if int64(reset) > now.Unix()/2 {
+			rateLimit.Reset = time.Unix(int64(reset), 0)
+		} else {
+			rateLimit.Reset = now.Add(time.Duration(reset) * time.Second)
+		}
+	}
+
+	ok := rateLimit.Limit >= 0 || rateLimit.Remaining >= 0 || reset > 0 || rateLimit.RetryAfter > 0
+	return rateLimit, ok
+}
+
+// parseHeaderInt returns the value of the first of names that is set in header, or -1.
+func parseHeaderInt(header http.Header, names ...string) int {
+	for _, name := range names {
+		value := header.Get(name)
+		if value == "" {
+			continue
+		}
+
+		n, err := strconv.Atoi(value)
+		if err == nil && n >= 0 {
+			return n
+		}
+	}
+	return -1
+}
+
+// parseRetryAfter parses a Retry-After header, which is either a number of seconds or an HTTP date.
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	if value == "" {
+		return 0
+	}
+
+	seconds, err := strconv.Atoi(value)
+	if err == nil {
+		if seconds < 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+
+	t, err := http.ParseTime(value)
+	if err != nil || !t.After(now) {
+		return 0
+	}
+	return t.Sub(now)
+}
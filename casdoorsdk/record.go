@@ -14,8 +14,12 @@
 
 package casdoorsdk
 
-import "encoding/json"
+import (
+	"context"
+	"encoding/json"
+)
 
+// Record has the same definition as https://github.com/casdoor/casdoor/blob/master/object/record.go#L30
 type Record struct {
 	Id int `xorm:"int notnull pk autoincr" json:"id"`
 
@@ -29,13 +33,81 @@ type Record struct {
 	Method       string `xorm:"varchar(100)" json:"method"`
 	RequestUri   string `xorm:"varchar(1000)" json:"requestUri"`
 	Action       string `xorm:"varchar(1000)" json:"action"`
+	Object       string `xorm:"mediumtext" json:"object"`
 
 	ExtendedUser *User `xorm:"-" json:"extendedUser"`
 
 	IsTriggered bool `json:"isTriggered"`
 }
 
-func AddRecord(record *Record) (bool, error) {
+// GetRecords returns the records of the organization, the audit trail of the requests sent to the server.
+func (c *Client) GetRecords() ([]*Record, error) {
+	return c.GetRecordsWithContext(context.Background())
+}
+
+func (c *Client) GetRecordsWithContext(ctx context.Context) ([]*Record, error) {
+	authConfig := c.getAuthConfig()
+
+	queryMap := map[string]string{
+		"owner": authConfig.OrganizationName,
+	}
+
+	url := c.GetUrl("get-records", queryMap)
+
+	bytes, err := c.DoGetBytesWithContext(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	var records []*Record
+	err = c.unmarshal(bytes, &records)
+	if err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+func (c *Client) GetPaginationRecords(p int, pageSize int, queryMap map[string]string) ([]*Record, int, error) {
+	return c.GetPaginationRecordsWithContext(context.Background(), p, pageSize, queryMap)
+}
+
+func (c *Client) GetPaginationRecordsWithContext(ctx context.Context, p int, pageSize int, queryMap map[string]string) ([]*Record, int, error) {
+	authConfig := c.getAuthConfig()
+
+	queryMap = getPaginationQueryMap(authConfig.OrganizationName, p, pageSize, queryMap)
+
+	url := c.GetUrl("get-records", queryMap)
+
+	response, err := c.DoGetResponseWithContext(ctx, url)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	bytes, err := json.Marshal(response.Data)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var records []*Record
+	err = c.unmarshal(bytes, &records)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	count, err := getCount(response)
+	if err != nil {
+		return nil, 0, err
+	}
+	return records, count, nil
+}
+
+func (c *Client) AddRecord(record *Record) (bool, error) {
+	return c.AddRecordWithContext(context.Background(), record)
+}
+
+func (c *Client) AddRecordWithContext(ctx context.Context, record *Record) (bool, error) {
+	authConfig := c.getAuthConfig()
+
 	if record.Owner == "" {
 		record.Owner = authConfig.OrganizationName
 	}
@@ -48,10 +120,10 @@ func AddRecord(record *Record) (bool, error) {
 		return false, err
 	}
 
-	resp, err := DoPost("add-record", nil, postBytes, false, false)
+	resp, err := c.DoPostWithContext(ctx, "add-record", nil, postBytes, false, false)
 	if err != nil {
 		return false, err
 	}
 
-	return resp.Data == "Affected", nil
+	return isAffected(resp), nil
 }
@@ -0,0 +1,144 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const redacted = "REDACTED"
+
+// nonSensitiveCodeNames are the names ending with "code" that don't hold a secret.
+var nonSensitiveCodeNames = map[string]bool{
+	"countrycode":  true,
+	"currencycode": true,
+	"errorcode":    true,
+	"languagecode": true,
+	"statuscode":   true,
+}
+
+// isSensitiveName reports whether a query parameter, header or JSON field named name holds a secret:
+// client secrets, passwords, access and refresh tokens, authorization, device, user and verification codes,
+// and credentials headers.
+func isSensitiveName(name string) bool {
+	name = strings.ToLower(strings.ReplaceAll(name, "_", ""))
+	switch name {
+	case "authorization", "cookie", "set-cookie":
+		return true
+	}
+	if (strings.HasSuffix(name, "code") || strings.HasSuffix(name, "codes")) && !nonSensitiveCodeNames[name] {
+		return true
+	}
+	return strings.Contains(name, "password") || strings.Contains(name, "secret") ||
+		strings.HasSuffix(name, "token") || strings.HasSuffix(name, "tokens")
+}
+
+// RedactUrl returns rawUrl with the values of its sensitive query parameters masked.
+// The query is processed as is, since the urls built by GetUrl are not always valid enough for url.Parse.
+func RedactUrl(rawUrl string) string {
+	i := strings.IndexByte(rawUrl, '?')
+	if i == -1 {
+		return rawUrl
+	}
+
+	params := strings.Split(rawUrl[i+1:], "&")
+	for j, param := range params {
+		key := strings.SplitN(param, "=", 2)[0]
+		unescapedKey, err := url.QueryUnescape(key)
+		if err != nil {
+			unescapedKey = key
+		}
+
+		if isSensitiveName(unescapedKey) {
+			params[j] = key + "=" + redacted
+		}
+	}
+
+	return rawUrl[:i+1] + strings.Join(params, "&")
+}
+
+// RedactHeader returns a copy of header with the values of its sensitive headers masked.
+func RedactHeader(header http.Header) http.Header {
+	res := make(http.Header, len(header))
+	for k, v := range header {
+		if isSensitiveName(k) {
+			res[k] = []string{redacted}
+		} else {
+			res[k] = v
+		}
+	}
+	return res
+}
+
+// RedactBody returns a copy of a JSON or form-encoded body with the values of its sensitive fields masked.
+// Bodies in other formats, and the ones without any sensitive field, are returned unchanged.
+func RedactBody(body []byte) []byte {
+	var v interface{}
+	if json.Unmarshal(body, &v) == nil {
+		if !redactJsonValue(v) {
+			return body
+		}
+		res, err := json.Marshal(v)
+		if err != nil {
+			return body
+		}
+		return res
+	}
+
+	form, err := url.ParseQuery(string(body))
+	if err != nil || len(form) == 0 || strings.ContainsAny(string(body), " \n") {
+		return body
+	}
+
+	isRedacted := false
+	for k := range form {
+		if isSensitiveName(k) {
+			form.Set(k, redacted)
+			isRedacted = true
+		}
+	}
+	if !isRedacted {
+		return body
+	}
+	return []byte(form.Encode())
+}
+
+// redactJsonValue masks the values of the sensitive fields of the objects of v in place,
+// and reports whether it masked any.
+func redactJsonValue(v interface{}) bool {
+	isRedacted := false
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, value := range v {
+			// whatever its type, e.g. a list of recovery codes or a numeric code
+			if value != nil && isSensitiveName(k) {
+				v[k] = redacted
+				isRedacted = true
+			} else if redactJsonValue(value) {
+				isRedacted = true
+			}
+		}
+	case []interface{}:
+		for _, value := range v {
+			if redactJsonValue(value) {
+				isRedacted = true
+			}
+		}
+	}
+	return isRedacted
+}
@@ -0,0 +1,133 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestIsSensitiveName(t *testing.T) {
+	tests := []struct {
+		name      string
+		sensitive bool
+	}{
+		{"code", true},
+		{"device_code", true},
+		{"user_code", true},
+		{"invitationCode", true},
+		{"recoveryCodes", true},
+		{"countryCode", false},
+		{"client_secret", true},
+		{"clientSecret", true},
+		{"password", true},
+		{"newPassword", true},
+		{"access_token", true},
+		{"refreshToken", true},
+		{"Authorization", true},
+		{"Cookie", true},
+		{"Set-Cookie", true},
+		{"name", false},
+		{"owner", false},
+		{"tokenType", false},
+	}
+
+	for _, test := range tests {
+		if isSensitiveName(test.name) != test.sensitive {
+			t.Errorf("isSensitiveName(%s) = %v, want %v", test.name, !test.sensitive, test.sensitive)
+		}
+	}
+}
+
+func TestRedactUrl(t *testing.T) {
+	got := RedactUrl("https://door.casdoor.com/api/login/oauth/access_token?grant_type=device_code&device_code=abc&clientSecret=s3cr3t&id=built-in/alice")
+	want := "https://door.casdoor.com/api/login/oauth/access_token?grant_type=device_code&device_code=REDACTED&clientSecret=REDACTED&id=built-in/alice"
+	if got != want {
+		t.Errorf("RedactUrl() = %s, want %s", got, want)
+	}
+}
+
+func TestRedactHeader(t *testing.T) {
+	header := http.Header{"Authorization": {"Bearer token"}, "Content-Type": {"application/json"}}
+	got := RedactHeader(header)
+	if got.Get("Authorization") != redacted || got.Get("Content-Type") != "application/json" {
+		t.Errorf("RedactHeader() = %v", got)
+	}
+	if header.Get("Authorization") != "Bearer token" {
+		t.Error("RedactHeader() modified the header")
+	}
+}
+
+func TestRedactBody(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want string
+	}{
+		{"json", `{"name":"alice","password":"123"}`, `{"name":"alice","password":"REDACTED"}`},
+		{"nested json", `{"data":[{"accessToken":"abc"}]}`, `{"data":[{"accessToken":"REDACTED"}]}`},
+		{"non-string values", `{"code":123456,"recoveryCodes":["a","b"],"secret":{"value":"x"}}`, `{"code":"REDACTED","recoveryCodes":"REDACTED","secret":"REDACTED"}`},
+		{"null value", `{"password":null}`, `{"password":null}`},
+		{"nothing sensitive", `{"name": "alice", "owner": "built-in"}`, `{"name": "alice", "owner": "built-in"}`},
+		{"form", `client_id=id&client_secret=s3cr3t&user_code=ABCD`, `client_id=id&client_secret=REDACTED&user_code=REDACTED`},
+		{"text", `NotFound`, `NotFound`},
+		{"html", `<html>404 page not found</html>`, `<html>404 page not found</html>`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := string(RedactBody([]byte(test.body)))
+			if got != test.want {
+				t.Errorf("RedactBody(%s) = %s, want %s", test.body, got, test.want)
+			}
+		})
+	}
+}
+
+func TestErrorBodiesAreRedacted(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/api/get-user" {
+			_, _ = w.Write([]byte(`{"status":"error","msg":"invalid","data":{"clientSecret":"s3cr3t"}}`))
+			return
+		}
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"error":"invalid","password":"123"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(&AuthConfig{Endpoint: server.URL, ClientId: "client-id", ClientSecret: "client-secret", OrganizationName: "built-in"})
+
+	_, err := client.GetUser("alice")
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("err = %v, want an APIError", err)
+	}
+	if strings.Contains(string(apiErr.Body), "s3cr3t") {
+		t.Errorf("the body of the APIError isn't redacted: %s", apiErr.Body)
+	}
+
+	_, err = client.DoGetBytesRaw(client.GetUrl("get-users", nil))
+	var statusErr *HTTPStatusError
+	if !errors.As(err, &statusErr) {
+		t.Fatalf("err = %v, want an HTTPStatusError", err)
+	}
+	if strings.Contains(statusErr.Body, "123") {
+		t.Errorf("the body of the HTTPStatusError isn't redacted: %s", statusErr.Body)
+	}
+}
@@ -0,0 +1,117 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+const (
+	// cacheRefreshTimeout bounds the fetches made in the background by a refreshingCache.
+	cacheRefreshTimeout = 30 * time.Second
+	// cacheRetryDelay is how long a refreshingCache waits after a failed background fetch before trying again.
+	cacheRetryDelay = time.Minute
+)
+
+// refreshingCache caches a value fetched from the server, like the discovery document, for the hot paths that must
+// not wait for the server. An expired value keeps being served while it is refreshed in the background, and the
+// fetches are done without holding the lock of the cache, once for the concurrent callers.
+// The value is cached along with a key, e.g. the endpoint it was fetched from, so that a value fetched for
+// a previous configuration is never served.
+// Its zero value is an empty cache, and its methods are given how long the value lasts and how to fetch it.
+type refreshingCache[T any] struct {
+	mu         sync.Mutex
+	key        string
+	value      T
+	cached     bool
+	expiresAt  time.Time
+	refreshing bool
+	failedAt   time.Time
+
+	fetches singleFlight[T]
+}
+
+// cacheFetch fetches the value of a refreshingCache.
+type cacheFetch[T any] func(ctx context.Context) (T, error)
+
+// get returns the value of key, waiting for it to be fetched only if it isn't cached.
+func (r *refreshingCache[T]) get(ctx context.Context, key string, ttl time.Duration, fetch cacheFetch[T]) (T, error) {
+	value, ok := r.peek(key, ttl, fetch, false)
+	if ok {
+		return value, nil
+	}
+	return r.refresh(ctx, key, ttl, fetch)
+}
+
+// peek returns the cached value of key without waiting. If it isn't cached and fetchMissing is true,
+// it is fetched in the background, unless a fetch failed less than cacheRetryDelay ago.
+func (r *refreshingCache[T]) peek(key string, ttl time.Duration, fetch cacheFetch[T], fetchMissing bool) (T, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.cached && r.key == key {
+		if time.Now().After(r.expiresAt) {
+			r.startRefresh(key, ttl, fetch)
+		}
+		return r.value, true
+	}
+
+	if fetchMissing && time.Since(r.failedAt) >= cacheRetryDelay {
+		r.startRefresh(key, ttl, fetch)
+	}
+	var zero T
+	return zero, false
+}
+
+// refresh fetches the value of key into the cache.
+func (r *refreshingCache[T]) refresh(ctx context.Context, key string, ttl time.Duration, fetch cacheFetch[T]) (T, error) {
+	return r.fetches.do(ctx, key, func() (T, error) {
+		value, err := fetch(ctx)
+
+		r.mu.Lock()
+		defer r.mu.Unlock()
+
+		if err != nil {
+			r.failedAt = time.Now()
+			return value, err
+		}
+		r.key = key
+		r.value = value
+		r.cached = true
+		r.expiresAt = time.Now().Add(ttl)
+		return value, nil
+	})
+}
+
+// startRefresh must be called with the mutex locked.
+func (r *refreshingCache[T]) startRefresh(key string, ttl time.Duration, fetch cacheFetch[T]) {
+	if r.refreshing {
+		return
+	}
+	r.refreshing = true
+
+	// the request that noticed the expiry must not cancel the refresh
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), cacheRefreshTimeout)
+		defer cancel()
+		_, _ = r.refresh(ctx, key, ttl, fetch)
+
+		r.mu.Lock()
+		r.refreshing = false
+		r.mu.Unlock()
+	}()
+}
@@ -15,8 +15,16 @@
 package casdoorsdk
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"path"
+	"strings"
 )
 
 // Resource has the same definition as https://github.com/casdoor/casdoor/blob/master/object/resource.go#L24
@@ -38,7 +46,153 @@ type Resource struct {
 	Description string `xorm:"varchar(1000)" json:"description"`
 }
 
-func UploadResource(user string, tag string, parent string, fullFilePath string, fileBytes []byte) (string, string, error) {
+func (c *Client) GetResources() ([]*Resource, error) {
+	return c.GetResourcesWithContext(context.Background())
+}
+
+func (c *Client) GetResourcesWithContext(ctx context.Context) ([]*Resource, error) {
+	authConfig := c.getAuthConfig()
+
+	queryMap := map[string]string{
+		"owner": authConfig.OrganizationName,
+	}
+
+	url := c.GetUrl("get-resources", queryMap)
+
+	bytes, err := c.DoGetBytesWithContext(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	var resources []*Resource
+	err = c.unmarshal(bytes, &resources)
+	if err != nil {
+		return nil, err
+	}
+	return resources, nil
+}
+
+func (c *Client) GetPaginationResources(p int, pageSize int, queryMap map[string]string) ([]*Resource, int, error) {
+	return c.GetPaginationResourcesWithContext(context.Background(), p, pageSize, queryMap)
+}
+
+func (c *Client) GetPaginationResourcesWithContext(ctx context.Context, p int, pageSize int, queryMap map[string]string) ([]*Resource, int, error) {
+	authConfig := c.getAuthConfig()
+
+	queryMap = getPaginationQueryMap(authConfig.OrganizationName, p, pageSize, queryMap)
+
+	url := c.GetUrl("get-resources", queryMap)
+
+	response, err := c.DoGetResponseWithContext(ctx, url)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	bytes, err := json.Marshal(response.Data)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var resources []*Resource
+	err = c.unmarshal(bytes, &resources)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	count, err := getCount(response)
+	if err != nil {
+		return nil, 0, err
+	}
+	return resources, count, nil
+}
+
+func (c *Client) GetResource(name string) (*Resource, error) {
+	return c.GetResourceWithContext(context.Background(), name)
+}
+
+func (c *Client) GetResourceWithContext(ctx context.Context, name string) (*Resource, error) {
+	authConfig := c.getAuthConfig()
+
+	queryMap := map[string]string{
+		"id": fmt.Sprintf("%s/%s", authConfig.OrganizationName, name),
+	}
+
+	url := c.GetUrl("get-resource", queryMap)
+
+	bytes, err := c.DoGetBytesWithContext(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	var resource *Resource
+	err = c.unmarshal(bytes, &resource)
+	if err != nil {
+		return nil, err
+	}
+	if resource == nil {
+		return nil, c.newAPIError(fmt.Sprintf("the resource: %s doesn't exist", name))
+	}
+	return resource, nil
+}
+
+// DownloadResource writes the file of the resource named name to w, and returns the number of bytes written.
+// The file is streamed from the url of the resource, without the credentials of the client unless it is
+// served by the Casdoor server itself, since it is usually stored by a third-party storage provider.
+func (c *Client) DownloadResource(name string, w io.Writer, callOptions ...CallOption) (int64, error) {
+	return c.DownloadResourceWithContext(context.Background(), name, w, callOptions...)
+}
+
+func (c *Client) DownloadResourceWithContext(ctx context.Context, name string, w io.Writer, callOptions ...CallOption) (int64, error) {
+	ctx = WithCallOptions(ctx, callOptions...)
+	resource, err := c.GetResourceWithContext(ctx, name)
+	if err != nil {
+		return 0, err
+	}
+
+	return c.downloadFile(ctx, resource.Url, w)
+}
+
+// downloadFile writes the file at fileUrl to w, fileUrl being relative to the endpoint if it is a path.
+// It is sent like the other requests, with the call options, retries, hooks and logger of the client,
+// but only with its credentials if it is served by the Casdoor server itself.
+func (c *Client) downloadFile(ctx context.Context, fileUrl string, w io.Writer) (int64, error) {
+	authConfig := c.getAuthConfig()
+
+	if strings.HasPrefix(fileUrl, "/") {
+		fileUrl = authConfig.Endpoint + fileUrl
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", fileUrl, nil)
+	if err != nil {
+		return 0, newRequestError("GET", fileUrl, err)
+	}
+
+	external := !strings.HasPrefix(fileUrl, authConfig.Endpoint+"/")
+	counter := &countingWriter{w: w}
+	_, _, err = c.sendWithRetries(req, external, counter)
+	return counter.n, err
+}
+
+// countingWriter counts the bytes written to w.
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	n, err := w.w.Write(p)
+	w.n += int64(n)
+	return n, err
+}
+
+func (c *Client) UploadResource(user string, tag string, parent string, fullFilePath string, fileBytes []byte, callOptions ...CallOption) (string, string, error) {
+	return c.UploadResourceWithContext(context.Background(), user, tag, parent, fullFilePath, fileBytes, callOptions...)
+}
+
+func (c *Client) UploadResourceWithContext(ctx context.Context, user string, tag string, parent string, fullFilePath string, fileBytes []byte, callOptions ...CallOption) (string, string, error) {
+	ctx = WithCallOptions(ctx, callOptions...)
+	authConfig := c.getAuthConfig()
+
 	queryMap := map[string]string{
 		"owner":        authConfig.OrganizationName,
 		"user":         user,
@@ -48,13 +202,13 @@ func UploadResource(user string, tag string, parent string, fullFilePath string,
 		"fullFilePath": fullFilePath,
 	}
 
-	resp, err := DoPost("upload-resource", queryMap, fileBytes, true, true)
+	resp, err := c.DoPostWithContext(ctx, "upload-resource", queryMap, fileBytes, true, true)
 	if err != nil {
 		return "", "", err
 	}
 
 	if resp.Status != "ok" {
-		return "", "", fmt.Errorf(resp.Msg)
+		return "", "", c.newAPIError(resp.Msg)
 	}
 
 	fileUrl := resp.Data.(string)
@@ -62,7 +216,14 @@ func UploadResource(user string, tag string, parent string, fullFilePath string,
 	return fileUrl, name, nil
 }
 
-func UploadResourceEx(user string, tag string, parent string, fullFilePath string, fileBytes []byte, createdTime string, description string) (string, string, error) {
+func (c *Client) UploadResourceEx(user string, tag string, parent string, fullFilePath string, fileBytes []byte, createdTime string, description string, callOptions ...CallOption) (string, string, error) {
+	return c.UploadResourceExWithContext(context.Background(), user, tag, parent, fullFilePath, fileBytes, createdTime, description, callOptions...)
+}
+
+func (c *Client) UploadResourceExWithContext(ctx context.Context, user string, tag string, parent string, fullFilePath string, fileBytes []byte, createdTime string, description string, callOptions ...CallOption) (string, string, error) {
+	ctx = WithCallOptions(ctx, callOptions...)
+	authConfig := c.getAuthConfig()
+
 	queryMap := map[string]string{
 		"owner":        authConfig.OrganizationName,
 		"user":         user,
@@ -74,13 +235,13 @@ func UploadResourceEx(user string, tag string, parent string, fullFilePath strin
 		"description":  description,
 	}
 
-	resp, err := DoPost("upload-resource", queryMap, fileBytes, true, true)
+	resp, err := c.DoPostWithContext(ctx, "upload-resource", queryMap, fileBytes, true, true)
 	if err != nil {
 		return "", "", err
 	}
 
 	if resp.Status != "ok" {
-		return "", "", fmt.Errorf(resp.Msg)
+		return "", "", c.newAPIError(resp.Msg)
 	}
 
 	fileUrl := resp.Data.(string)
@@ -88,7 +249,126 @@ func UploadResourceEx(user string, tag string, parent string, fullFilePath strin
 	return fileUrl, name, nil
 }
 
-func DeleteResource(name string) (bool, error) {
+// UploadResourceOptions are the parameters of UploadResourceStream.
+type UploadResourceOptions struct {
+	User        string
+	Tag         string
+	Parent      string
+	CreatedTime string
+	Description string
+
+	// FileName is the name of the uploaded file, the base name of the fullFilePath by default.
+	FileName string
+	// ContentType is the type of the uploaded file, which the server derives the FileType of the resource from,
+	// "application/octet-stream" by default.
+	ContentType string
+}
+
+// UploadResourceStream is UploadResource for a file read from reader, which is streamed to the server instead
+// of being loaded in memory. size is the size of the file, or -1 if it isn't known, in which case the request
+// is sent chunked. options can be nil. As reader can't be read again, the upload is never retried.
+func (c *Client) UploadResourceStream(fullFilePath string, reader io.Reader, size int64, options *UploadResourceOptions, callOptions ...CallOption) (string, string, error) {
+	return c.UploadResourceStreamWithContext(context.Background(), fullFilePath, reader, size, options, callOptions...)
+}
+
+func (c *Client) UploadResourceStreamWithContext(ctx context.Context, fullFilePath string, reader io.Reader, size int64, options *UploadResourceOptions, callOptions ...CallOption) (string, string, error) {
+	ctx = WithCallOptions(ctx, callOptions...)
+	authConfig := c.getAuthConfig()
+
+	if options == nil {
+		options = &UploadResourceOptions{}
+	}
+
+	queryMap := map[string]string{
+		"owner":        authConfig.OrganizationName,
+		"user":         options.User,
+		"application":  authConfig.ApplicationName,
+		"tag":          options.Tag,
+		"parent":       options.Parent,
+		"fullFilePath": fullFilePath,
+	}
+	if options.CreatedTime != "" {
+		queryMap["createdTime"] = options.CreatedTime
+	}
+	if options.Description != "" {
+		queryMap["description"] = options.Description
+	}
+
+	fileName := options.FileName
+	if fileName == "" {
+		fileName = path.Base(fullFilePath)
+	}
+	contentType := options.ContentType
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+
+	// the multipart body is the part header, the file and the closing boundary, only the file is streamed
+	var buf bytes.Buffer
+	w := multipart.NewWriter(&buf)
+	header := textproto.MIMEHeader{}
+	header.Set("Content-Disposition", fmt.Sprintf(`form-data; name="file"; filename="%s"`, escapeQuotes(fileName)))
+	header.Set("Content-Type", contentType)
+	_, err := w.CreatePart(header)
+	if err != nil {
+		return "", "", err
+	}
+	headLength := buf.Len()
+	err = w.Close()
+	if err != nil {
+		return "", "", err
+	}
+	head := buf.Bytes()[:headLength]
+	tail := buf.Bytes()[headLength:]
+
+	contentLength := int64(-1)
+	if size >= 0 {
+		contentLength = int64(len(head)) + size + int64(len(tail))
+		reader = io.LimitReader(reader, size)
+	}
+	body := io.MultiReader(bytes.NewReader(head), reader, bytes.NewReader(tail))
+
+	url := c.GetUrl("upload-resource", queryMap)
+	respBytes, statusCode, err := c.doPostWithLength(ctx, url, w.FormDataContentType(), body, contentLength)
+	if err != nil {
+		return "", "", err
+	}
+
+	var response Response
+	err = json.Unmarshal(respBytes, &response)
+	if err != nil {
+		return "", "", err
+	}
+
+	if response.Status != "ok" {
+		return "", "", c.newResponseError("POST", url, statusCode, respBytes, response.Msg)
+	}
+
+	fileUrl, ok := response.Data.(string)
+	if !ok {
+		return "", "", fmt.Errorf("invalid file url: %v", response.Data)
+	}
+	name, ok := response.Data2.(string)
+	if !ok {
+		return "", "", fmt.Errorf("invalid resource name: %v", response.Data2)
+	}
+	return fileUrl, name, nil
+}
+
+var quoteEscaper = strings.NewReplacer("\\", "\\\\", `"`, "\\\"")
+
+// escapeQuotes escapes a file name for a Content-Disposition header, the way mime/multipart does.
+func escapeQuotes(s string) string {
+	return quoteEscaper.Replace(s)
+}
+
+func (c *Client) DeleteResource(name string) (bool, error) {
+	return c.DeleteResourceWithContext(context.Background(), name)
+}
+
+func (c *Client) DeleteResourceWithContext(ctx context.Context, name string) (bool, error) {
+	authConfig := c.getAuthConfig()
+
 	resource := Resource{
 		Owner: authConfig.OrganizationName,
 		Name:  name,
@@ -98,10 +378,10 @@ func DeleteResource(name string) (bool, error) {
 		return false, err
 	}
 
-	resp, err := DoPost("delete-resource", nil, postBytes, false, false)
+	resp, err := c.DoPostWithContext(ctx, "delete-resource", nil, postBytes, false, false)
 	if err != nil {
 		return false, err
 	}
 
-	return resp.Data == "Affected", nil
+	return isAffected(resp), nil
 }
@@ -0,0 +1,84 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import (
+	"context"
+	"io"
+)
+
+func GetResources() ([]*Resource, error) {
+	return globalClient.GetResources()
+}
+
+func GetResourcesWithContext(ctx context.Context) ([]*Resource, error) {
+	return globalClient.GetResourcesWithContext(ctx)
+}
+
+func GetPaginationResources(p int, pageSize int, queryMap map[string]string) ([]*Resource, int, error) {
+	return globalClient.GetPaginationResources(p, pageSize, queryMap)
+}
+
+func GetPaginationResourcesWithContext(ctx context.Context, p int, pageSize int, queryMap map[string]string) ([]*Resource, int, error) {
+	return globalClient.GetPaginationResourcesWithContext(ctx, p, pageSize, queryMap)
+}
+
+func GetResource(name string) (*Resource, error) {
+	return globalClient.GetResource(name)
+}
+
+func GetResourceWithContext(ctx context.Context, name string) (*Resource, error) {
+	return globalClient.GetResourceWithContext(ctx, name)
+}
+
+func DownloadResource(name string, w io.Writer, callOptions ...CallOption) (int64, error) {
+	return globalClient.DownloadResource(name, w, callOptions...)
+}
+
+func DownloadResourceWithContext(ctx context.Context, name string, w io.Writer, callOptions ...CallOption) (int64, error) {
+	return globalClient.DownloadResourceWithContext(ctx, name, w, callOptions...)
+}
+
+func UploadResource(user string, tag string, parent string, fullFilePath string, fileBytes []byte, callOptions ...CallOption) (string, string, error) {
+	return globalClient.UploadResource(user, tag, parent, fullFilePath, fileBytes, callOptions...)
+}
+
+func UploadResourceWithContext(ctx context.Context, user string, tag string, parent string, fullFilePath string, fileBytes []byte, callOptions ...CallOption) (string, string, error) {
+	return globalClient.UploadResourceWithContext(ctx, user, tag, parent, fullFilePath, fileBytes, callOptions...)
+}
+
+func UploadResourceEx(user string, tag string, parent string, fullFilePath string, fileBytes []byte, createdTime string, description string, callOptions ...CallOption) (string, string, error) {
+	return globalClient.UploadResourceEx(user, tag, parent, fullFilePath, fileBytes, createdTime, description, callOptions...)
+}
+
+func UploadResourceExWithContext(ctx context.Context, user string, tag string, parent string, fullFilePath string, fileBytes []byte, createdTime string, description string, callOptions ...CallOption) (string, string, error) {
+	return globalClient.UploadResourceExWithContext(ctx, user, tag, parent, fullFilePath, fileBytes, createdTime, description, callOptions...)
+}
+
+func UploadResourceStream(fullFilePath string, reader io.Reader, size int64, options *UploadResourceOptions, callOptions ...CallOption) (string, string, error) {
+	return globalClient.UploadResourceStream(fullFilePath, reader, size, options, callOptions...)
+}
+
+func UploadResourceStreamWithContext(ctx context.Context, fullFilePath string, reader io.Reader, size int64, options *UploadResourceOptions, callOptions ...CallOption) (string, string, error) {
+	return globalClient.UploadResourceStreamWithContext(ctx, fullFilePath, reader, size, options, callOptions...)
+}
+
+func DeleteResource(name string) (bool, error) {
+	return globalClient.DeleteResource(name)
+}
+
+func DeleteResourceWithContext(ctx context.Context, name string) (bool, error) {
+	return globalClient.DeleteResourceWithContext(ctx, name)
+}
@@ -0,0 +1,72 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestDownloadResource(t *testing.T) {
+	storage := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "" {
+			t.Error("the credentials of the client are sent to the storage provider")
+		}
+		if r.Header.Get("X-Request-Id") != "42" {
+			t.Error("the headers of the call options aren't sent")
+		}
+		if r.URL.Path != "/files/avatar.png" {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write([]byte("file content"))
+	}))
+	defer storage.Close()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Query().Get("id") {
+		case "built-in/avatar.png":
+			_, _ = w.Write([]byte(`{"status":"ok","data":{"owner":"built-in","name":"avatar.png","url":"` + storage.URL + `/files/avatar.png"}}`))
+		default:
+			_, _ = w.Write([]byte(`{"status":"ok","data":{"owner":"built-in","name":"missing.png","url":"` + storage.URL + `/files/missing.png"}}`))
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(&AuthConfig{Endpoint: server.URL, ClientId: "client-id", ClientSecret: "client-secret", OrganizationName: "built-in"})
+
+	var buf bytes.Buffer
+	n, err := client.DownloadResource("avatar.png", &buf, WithHeader("X-Request-Id", "42"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if buf.String() != "file content" || n != int64(buf.Len()) {
+		t.Errorf("downloaded %d bytes: %q, want the file content", n, buf.String())
+	}
+
+	buf.Reset()
+	_, err = client.DownloadResource("missing.png", &buf, WithHeader("X-Request-Id", "42"))
+	var statusErr *HTTPStatusError
+	if !errors.As(err, &statusErr) || statusErr.StatusCode != http.StatusNotFound {
+		t.Fatalf("err = %v, want a 404 HTTPStatusError", err)
+	}
+	if buf.Len() != 0 {
+		t.Errorf("the body of the failed download was written: %q", buf.String())
+	}
+}
@@ -0,0 +1,159 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import (
+	"io"
+	"net/http"
+	"time"
+)
+
+const (
+	defaultRetryInitialDelay = 200 * time.Millisecond
+	defaultRetryMaxDelay     = 10 * time.Second
+	retryJitter              = 0.2
+)
+
+// RetryOptions configures how the requests failing with a transient error (see IsRetryable),
+// like a dropped connection or a 502, 503 or 504 response, are sent again.
+// Note that a retried POST may be applied twice if the server handled it but the response got lost.
+type RetryOptions struct {
+	// MaxAttempts is the number of times a request is sent at most, including the first one.
+	// 0 or 1 disables the retries, which is the default.
+	MaxAttempts int
+	// InitialDelay is the delay before the first retry, doubled before each next one, 200ms by default.
+	// A random jitter of 20% is applied to every delay.
+	InitialDelay time.Duration
+	// MaxDelay caps the delays, including the ones asked by the server with Retry-After, 10s by default.
+	MaxDelay time.Duration
+}
+
+// SetRetryOptions makes the client retry the requests failing with a transient error.
+func (c *Client) SetRetryOptions(options RetryOptions) {
+	if options.InitialDelay <= 0 {
+		options.InitialDelay = defaultRetryInitialDelay
+	}
+	if options.MaxDelay <= 0 {
+		options.MaxDelay = defaultRetryMaxDelay
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.retryOptions = options
+}
+
+func (c *Client) getRetryOptions() RetryOptions {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.retryOptions
+}
+
+// getDelay returns how long to wait before the retry following the failed attempt (counted from 1).
+func (o RetryOptions) getDelay(attempt int, err error) time.Duration {
+	delay := o.InitialDelay
+	for i := 1; i < attempt && delay < o.MaxDelay; i++ {
+		delay *= 2
+	}
+	delay = addJitter(delay, retryJitter)
+
+	if retryAfter, ok := GetRetryAfter(err); ok && retryAfter > delay {
+		delay = retryAfter
+	}
+	if delay > o.MaxDelay {
+		delay = o.MaxDelay
+	}
+	return delay
+}
+
+// doRequestWithRetries authenticates req and sends it by doRequest, and again while it fails with a transient error,
+// as configured by SetRetryOptions, with the call options of its context, waiting for the limiter if any
+// and failing fast while the circuit breaker is open.
+func (c *Client) doRequestWithRetries(req *http.Request) ([]byte, int, error) {
+	return c.sendWithRetries(req, false, nil)
+}
+
+// sendWithRetries is doRequestWithRetries, copying the body of the response to w if it isn't nil, see doRequest.
+// A request to another server than Casdoor, like a file of a storage provider, is external: it is sent without
+// the credentials of the client, and its failures don't count for the circuit breaker.
+func (c *Client) sendWithRetries(req *http.Request, external bool, w io.Writer) ([]byte, int, error) {
+	options := c.getRetryOptions()
+
+	req, cancel := applyCallTimeout(req)
+	defer cancel()
+
+	if !external {
+		// authenticating may wait for the service token, within the timeout of the call
+		err := c.setAuthorization(req)
+		if err != nil {
+			return nil, 0, newRequestError(req.Method, req.URL.String(), err)
+		}
+	}
+	applyCallHeaders(req)
+
+	limiter := c.getLimiter()
+	var breaker *circuitBreaker
+	if !external {
+		breaker = c.getCircuitBreaker()
+	}
+
+	for attempt := 1; ; attempt++ {
+		if limiter != nil {
+			err := limiter.Wait(req.Context())
+			if err != nil {
+				return nil, 0, newRequestError(req.Method, req.URL.String(), err)
+			}
+		}
+
+		probe := false
+		if breaker != nil {
+			var ok bool
+			ok, probe = breaker.allow(time.Now())
+			if !ok {
+				return nil, 0, newRequestError(req.Method, req.URL.String(), ErrCircuitOpen)
+			}
+		}
+
+		respBytes, statusCode, err := c.doRequest(req, w)
+		if breaker != nil {
+			breaker.record(probe, err, time.Now())
+		}
+		if err == nil || !IsRetryable(err) || attempt >= options.MaxAttempts {
+			return respBytes, statusCode, err
+		}
+
+		// the body has been consumed, it can only be sent again if it can be reset
+		if req.Body != nil && req.Body != http.NoBody {
+			if req.GetBody == nil {
+				return respBytes, statusCode, err
+			}
+			body, bodyErr := req.GetBody()
+			if bodyErr != nil {
+				return respBytes, statusCode, err
+			}
+			req = req.Clone(req.Context())
+			req.Body = body
+		}
+
+		timer := time.NewTimer(options.getDelay(attempt, err))
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return respBytes, statusCode, err
+		case <-timer.C:
+		}
+	}
+}
@@ -0,0 +1,114 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newFlakyServer returns a server answering the first failures requests with status, and then with a user,
+// and the number of requests it received.
+func newFlakyServer(t *testing.T, failures int32, status int) (*httptest.Server, *int32) {
+	t.Helper()
+
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&requests, 1) <= failures {
+			w.WriteHeader(status)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"owner":"built-in","name":"alice"}`))
+	}))
+	t.Cleanup(server.Close)
+	return server, &requests
+}
+
+func newRetryingClient(endpoint string, maxAttempts int) *Client {
+	client := NewClient(&AuthConfig{Endpoint: endpoint, ClientId: "client-id", ClientSecret: "client-secret", OrganizationName: "built-in"})
+	client.SetRetryOptions(RetryOptions{MaxAttempts: maxAttempts, InitialDelay: time.Millisecond, MaxDelay: time.Millisecond})
+	return client
+}
+
+func TestRetries(t *testing.T) {
+	tests := []struct {
+		name        string
+		failures    int32
+		status      int
+		maxAttempts int
+		requests    int32
+		ok          bool
+	}{
+		{"transient failures", 2, http.StatusServiceUnavailable, 3, 3, true},
+		{"too many failures", 3, http.StatusBadGateway, 3, 3, false},
+		{"rate limit", 1, http.StatusTooManyRequests, 3, 2, true},
+		{"client error", 1, http.StatusBadRequest, 3, 1, false},
+		{"retries disabled", 1, http.StatusServiceUnavailable, 0, 1, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server, requests := newFlakyServer(t, test.failures, test.status)
+			client := newRetryingClient(server.URL, test.maxAttempts)
+
+			user, err := client.GetUser("alice")
+			if test.ok && (err != nil || user == nil || user.Name != "alice") {
+				t.Errorf("GetUser() = %v, %v, want alice", user, err)
+			}
+			if !test.ok {
+				var statusErr *HTTPStatusError
+				if !errors.As(err, &statusErr) || statusErr.StatusCode != test.status {
+					t.Errorf("err = %v, want the %d status", err, test.status)
+				}
+			}
+			if n := atomic.LoadInt32(requests); n != test.requests {
+				t.Errorf("the server got %d requests, want %d", n, test.requests)
+			}
+		})
+	}
+}
+
+func TestRetriesResendTheBody(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var user User
+		err := json.NewDecoder(r.Body).Decode(&user)
+		if err != nil || user.Name != "alice" {
+			t.Errorf("the request %d doesn't have the user: %v", atomic.LoadInt32(&requests)+1, err)
+		}
+		if atomic.AddInt32(&requests, 1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"status":"ok","data":"Affected"}`))
+	}))
+	defer server.Close()
+
+	client := newRetryingClient(server.URL, 2)
+	affected, err := client.AddUser(&User{Owner: "built-in", Name: "alice"})
+	if err != nil || !affected {
+		t.Fatalf("AddUser() = %v, %v, want true", affected, err)
+	}
+	if n := atomic.LoadInt32(&requests); n != 2 {
+		t.Errorf("the server got %d requests, want 2", n)
+	}
+}
@@ -15,9 +15,9 @@
 package casdoorsdk
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
-	"strconv"
 )
 
 // Role has the same definition as https://github.com/casdoor/casdoor/blob/master/object/role.go#L24
@@ -31,36 +31,48 @@ type Role struct {
 	Roles     []string `xorm:"mediumtext" json:"roles"`
 	Domains   []string `xorm:"mediumtext" json:"domains"`
 	IsEnabled bool     `json:"isEnabled"`
+
+	unknownFields map[string]json.RawMessage
+}
+
+func (c *Client) GetRoles() ([]*Role, error) {
+	return c.GetRolesWithContext(context.Background())
 }
 
-func GetRoles() ([]*Role, error) {
+func (c *Client) GetRolesWithContext(ctx context.Context) ([]*Role, error) {
+	authConfig := c.getAuthConfig()
+
 	queryMap := map[string]string{
 		"owner": authConfig.OrganizationName,
 	}
 
-	url := GetUrl("get-roles", queryMap)
+	url := c.GetUrl("get-roles", queryMap)
 
-	bytes, err := DoGetBytesRaw(url)
+	bytes, err := c.DoGetBytesRawWithContext(ctx, url)
 	if err != nil {
 		return nil, err
 	}
 
 	var roles []*Role
-	err = json.Unmarshal(bytes, &roles)
+	err = c.unmarshal(bytes, &roles)
 	if err != nil {
 		return nil, err
 	}
 	return roles, nil
 }
 
-func GetPaginationRoles(p int, pageSize int, queryMap map[string]string) ([]*Role, int, error) {
-	queryMap["owner"] = authConfig.OrganizationName
-	queryMap["p"] = strconv.Itoa(p)
-	queryMap["pageSize"] = strconv.Itoa(pageSize)
+func (c *Client) GetPaginationRoles(p int, pageSize int, queryMap map[string]string) ([]*Role, int, error) {
+	return c.GetPaginationRolesWithContext(context.Background(), p, pageSize, queryMap)
+}
+
+func (c *Client) GetPaginationRolesWithContext(ctx context.Context, p int, pageSize int, queryMap map[string]string) ([]*Role, int, error) {
+	authConfig := c.getAuthConfig()
 
-	url := GetUrl("get-roles", queryMap)
+	queryMap = getPaginationQueryMap(authConfig.OrganizationName, p, pageSize, queryMap)
 
-	response, err := DoGetResponse(url)
+	url := c.GetUrl("get-roles", queryMap)
+
+	response, err := c.DoGetResponseWithContext(ctx, url)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -71,49 +83,96 @@ func GetPaginationRoles(p int, pageSize int, queryMap map[string]string) ([]*Rol
 	}
 
 	var roles []*Role
-	err = json.Unmarshal(bytes, &roles)
+	err = c.unmarshal(bytes, &roles)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	count, err := getCount(response)
 	if err != nil {
 		return nil, 0, err
 	}
-	return roles, int(response.Data2.(float64)), nil
+	return roles, count, nil
+}
+
+func (c *Client) GetRole(name string) (*Role, error) {
+	return c.GetRoleWithContext(context.Background(), name)
 }
 
-func GetRole(name string) (*Role, error) {
+func (c *Client) GetRoleWithContext(ctx context.Context, name string) (*Role, error) {
+	authConfig := c.getAuthConfig()
+
 	queryMap := map[string]string{
 		"id": fmt.Sprintf("%s/%s", authConfig.OrganizationName, name),
 	}
 
-	url := GetUrl("get-role", queryMap)
+	url := c.GetUrl("get-role", queryMap)
 
-	bytes, err := DoGetBytesRaw(url)
+	bytes, err := c.DoGetBytesRawWithContext(ctx, url)
 	if err != nil {
 		return nil, err
 	}
 
 	var role *Role
-	err = json.Unmarshal(bytes, &role)
+	err = c.unmarshal(bytes, &role)
 	if err != nil {
 		return nil, err
 	}
 	return role, nil
 }
 
-func UpdateRole(role *Role) (bool, error) {
-	_, affected, err := modifyRole("update-role", role, nil)
+func (c *Client) UpdateRole(role *Role) (bool, error) {
+	return c.UpdateRoleWithContext(context.Background(), role)
+}
+
+func (c *Client) UpdateRoleWithContext(ctx context.Context, role *Role) (bool, error) {
+	_, affected, err := c.modifyRole(ctx, "update-role", role, nil)
 	return affected, err
 }
 
-func UpdateRoleForColumns(role *Role, columns []string) (bool, error) {
-	_, affected, err := modifyRole("update-role", role, columns)
+func (c *Client) UpdateRoleForColumns(role *Role, columns []string) (bool, error) {
+	return c.UpdateRoleForColumnsWithContext(context.Background(), role, columns)
+}
+
+func (c *Client) UpdateRoleForColumnsWithContext(ctx context.Context, role *Role, columns []string) (bool, error) {
+	_, affected, err := c.modifyRole(ctx, "update-role", role, columns)
 	return affected, err
 }
 
-func AddRole(role *Role) (bool, error) {
-	_, affected, err := modifyRole("add-role", role, nil)
+func (c *Client) AddRole(role *Role) (bool, error) {
+	return c.AddRoleWithContext(context.Background(), role)
+}
+
+func (c *Client) AddRoleWithContext(ctx context.Context, role *Role) (bool, error) {
+	_, affected, err := c.modifyRole(ctx, "add-role", role, nil)
 	return affected, err
 }
 
-func DeleteRole(role *Role) (bool, error) {
-	_, affected, err := modifyRole("delete-role", role, nil)
+func (c *Client) DeleteRole(role *Role) (bool, error) {
+	return c.DeleteRoleWithContext(context.Background(), role)
+}
+
+func (c *Client) DeleteRoleWithContext(ctx context.Context, role *Role) (bool, error) {
+	_, affected, err := c.modifyRole(ctx, "delete-role", role, nil)
 	return affected, err
 }
+
+func (r *Role) UnmarshalJSON(data []byte) error {
+	type role Role
+	unknownFields, err := unmarshalWithUnknownFields(data, (*role)(r))
+	if err != nil {
+		return err
+	}
+
+	r.unknownFields = unknownFields
+	return nil
+}
+
+func (r Role) getUnknownFields() map[string]json.RawMessage {
+	return r.unknownFields
+}
+
+func (r Role) MarshalJSON() ([]byte, error) {
+	type role Role
+	return marshalWithUnknownFields(r.unknownFields, role(r))
+}
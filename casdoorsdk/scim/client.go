@@ -0,0 +1,222 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package scim is a client of the SCIM 2.0 provisioning endpoint of Casdoor (https://datatracker.ietf.org/doc/html/rfc7644),
+// for provisioning systems standardized on SCIM.
+package scim
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+const (
+	contentType = "application/scim+json"
+
+	ListResponseSchema = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	PatchOpSchema      = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+	ErrorSchema        = "urn:ietf:params:scim:api:messages:2.0:Error"
+)
+
+// HttpClient has the method required to use a type as custom http client, like in the casdoorsdk package.
+type HttpClient interface {
+	Do(*http.Request) (*http.Response, error)
+}
+
+// Client sends SCIM requests to a Casdoor server, authenticated with the credentials of an application.
+type Client struct {
+	endpoint     string
+	clientId     string
+	clientSecret string
+	httpClient   HttpClient
+}
+
+// NewClient returns a Client of the Casdoor server at endpoint, e.g. "http://localhost:8000".
+func NewClient(endpoint string, clientId string, clientSecret string) *Client {
+	return &Client{
+		endpoint:     strings.TrimRight(endpoint, "/"),
+		clientId:     clientId,
+		clientSecret: clientSecret,
+		httpClient:   &http.Client{},
+	}
+}
+
+// SetHttpClient sets custom http Client.
+func (c *Client) SetHttpClient(httpClient HttpClient) {
+	c.httpClient = httpClient
+}
+
+type Meta struct {
+	ResourceType string `json:"resourceType,omitempty"`
+	Created      string `json:"created,omitempty"`
+	LastModified string `json:"lastModified,omitempty"`
+	Location     string `json:"location,omitempty"`
+	Version      string `json:"version,omitempty"`
+}
+
+// ListOptions are the query parameters of list requests.
+type ListOptions struct {
+	// Filter is a SCIM filter expression, see Eq and the other filter helpers.
+	Filter string
+	// StartIndex is the 1-based index of the first resource to return.
+	StartIndex int
+	// Count is the maximum number of resources to return.
+	Count int
+}
+
+type ListResponse[T any] struct {
+	Schemas      []string `json:"schemas"`
+	TotalResults int      `json:"totalResults"`
+	StartIndex   int      `json:"startIndex"`
+	ItemsPerPage int      `json:"itemsPerPage"`
+	Resources    []T      `json:"Resources"`
+}
+
+// PatchOperation is an operation of a PATCH request, whose Op is "add", "remove" or "replace".
+type PatchOperation struct {
+	Op    string      `json:"op"`
+	Path  string      `json:"path,omitempty"`
+	Value interface{} `json:"value,omitempty"`
+}
+
+type patchRequest struct {
+	Schemas    []string         `json:"schemas"`
+	Operations []PatchOperation `json:"Operations"`
+}
+
+// Error is returned when the server answers with a SCIM error.
+type Error struct {
+	Schemas  []string `json:"schemas"`
+	Status   string   `json:"status"`
+	ScimType string   `json:"scimType"`
+	Detail   string   `json:"detail"`
+}
+
+func (e *Error) Error() string {
+	if e.ScimType != "" {
+		return fmt.Sprintf("scim: %s (%s): %s", e.Status, e.ScimType, e.Detail)
+	}
+	return fmt.Sprintf("scim: %s: %s", e.Status, e.Detail)
+}
+
+// Eq returns a filter matching the resources whose attribute equals value, e.g. Eq("userName", "alice").
+func Eq(attribute string, value string) string {
+	return fmt.Sprintf("%s eq %s", attribute, strconv.Quote(value))
+}
+
+// Co returns a filter matching the resources whose attribute contains value.
+func Co(attribute string, value string) string {
+	return fmt.Sprintf("%s co %s", attribute, strconv.Quote(value))
+}
+
+// Sw returns a filter matching the resources whose attribute starts with value.
+func Sw(attribute string, value string) string {
+	return fmt.Sprintf("%s sw %s", attribute, strconv.Quote(value))
+}
+
+// And returns a filter matching the resources that match all filters.
+func And(filters ...string) string {
+	return "(" + strings.Join(filters, ") and (") + ")"
+}
+
+// Or returns a filter matching the resources that match any of filters.
+func Or(filters ...string) string {
+	return "(" + strings.Join(filters, ") or (") + ")"
+}
+
+func (o *ListOptions) query() string {
+	if o == nil {
+		return ""
+	}
+
+	query := url.Values{}
+	if o.Filter != "" {
+		query.Set("filter", o.Filter)
+	}
+	if o.StartIndex > 0 {
+		query.Set("startIndex", strconv.Itoa(o.StartIndex))
+	}
+	if o.Count > 0 {
+		query.Set("count", strconv.Itoa(o.Count))
+	}
+
+	if len(query) == 0 {
+		return ""
+	}
+	return "?" + query.Encode()
+}
+
+// do sends a request to path, relative to the SCIM endpoint, and decodes the response into res if it isn't nil.
+func (c *Client) do(ctx context.Context, method string, path string, body interface{}, res interface{}) error {
+	var reader io.Reader
+	if body != nil {
+		postBytes, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(postBytes)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, fmt.Sprintf("%s/scim%s", c.endpoint, path), reader)
+	if err != nil {
+		return err
+	}
+
+	req.SetBasicAuth(c.clientId, c.clientSecret)
+	req.Header.Set("Accept", contentType)
+	if body != nil {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	respBytes, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		scimErr := &Error{}
+		if json.Unmarshal(respBytes, scimErr) != nil || scimErr.Detail == "" {
+			scimErr.Status = strconv.Itoa(resp.StatusCode)
+			scimErr.Detail = strings.TrimSpace(string(respBytes))
+		}
+		return scimErr
+	}
+
+	if res == nil || len(respBytes) == 0 {
+		return nil
+	}
+	return json.Unmarshal(respBytes, res)
+}
+
+func (c *Client) patch(ctx context.Context, path string, operations []PatchOperation, res interface{}) error {
+	req := patchRequest{
+		Schemas:    []string{PatchOpSchema},
+		Operations: operations,
+	}
+	return c.do(ctx, http.MethodPatch, path, req, res)
+}
@@ -0,0 +1,132 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scim
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+const GroupSchema = "urn:ietf:params:scim:schemas:core:2.0:Group"
+
+// Member is a member of a group.
+type Member struct {
+	Value   string `json:"value"`
+	Ref     string `json:"$ref,omitempty"`
+	Display string `json:"display,omitempty"`
+	Type    string `json:"type,omitempty"`
+}
+
+// Group is the SCIM core group resource, see https://datatracker.ietf.org/doc/html/rfc7643#section-4.2
+type Group struct {
+	Schemas    []string `json:"schemas"`
+	Id         string   `json:"id,omitempty"`
+	ExternalId string   `json:"externalId,omitempty"`
+	Meta       *Meta    `json:"meta,omitempty"`
+
+	DisplayName string   `json:"displayName"`
+	Members     []Member `json:"members,omitempty"`
+}
+
+// ListGroups lists the groups matching options, which can be nil to list the first page of all groups.
+func (c *Client) ListGroups(options *ListOptions) (*ListResponse[*Group], error) {
+	return c.ListGroupsWithContext(context.Background(), options)
+}
+
+func (c *Client) ListGroupsWithContext(ctx context.Context, options *ListOptions) (*ListResponse[*Group], error) {
+	var res ListResponse[*Group]
+	err := c.do(ctx, http.MethodGet, "/Groups"+options.query(), nil, &res)
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+func (c *Client) GetGroup(id string) (*Group, error) {
+	return c.GetGroupWithContext(context.Background(), id)
+}
+
+func (c *Client) GetGroupWithContext(ctx context.Context, id string) (*Group, error) {
+	var group Group
+	err := c.do(ctx, http.MethodGet, getGroupPath(id), nil, &group)
+	if err != nil {
+		return nil, err
+	}
+	return &group, nil
+}
+
+// CreateGroup creates group and returns it as created by the server.
+func (c *Client) CreateGroup(group *Group) (*Group, error) {
+	return c.CreateGroupWithContext(context.Background(), group)
+}
+
+func (c *Client) CreateGroupWithContext(ctx context.Context, group *Group) (*Group, error) {
+	if len(group.Schemas) == 0 {
+		group.Schemas = []string{GroupSchema}
+	}
+
+	var res Group
+	err := c.do(ctx, http.MethodPost, "/Groups", group, &res)
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// ReplaceGroup replaces all the attributes of the group with id by the ones of group.
+func (c *Client) ReplaceGroup(id string, group *Group) (*Group, error) {
+	return c.ReplaceGroupWithContext(context.Background(), id, group)
+}
+
+func (c *Client) ReplaceGroupWithContext(ctx context.Context, id string, group *Group) (*Group, error) {
+	if len(group.Schemas) == 0 {
+		group.Schemas = []string{GroupSchema}
+	}
+
+	var res Group
+	err := c.do(ctx, http.MethodPut, getGroupPath(id), group, &res)
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// PatchGroup applies operations to the group with id, e.g. {Op: "add", Path: "members", Value: []Member{{Value: userId}}}.
+func (c *Client) PatchGroup(id string, operations ...PatchOperation) (*Group, error) {
+	return c.PatchGroupWithContext(context.Background(), id, operations...)
+}
+
+func (c *Client) PatchGroupWithContext(ctx context.Context, id string, operations ...PatchOperation) (*Group, error) {
+	var res Group
+	err := c.patch(ctx, getGroupPath(id), operations, &res)
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+func (c *Client) DeleteGroup(id string) error {
+	return c.DeleteGroupWithContext(context.Background(), id)
+}
+
+func (c *Client) DeleteGroupWithContext(ctx context.Context, id string) error {
+	return c.do(ctx, http.MethodDelete, getGroupPath(id), nil, nil)
+}
+
+func getGroupPath(id string) string {
+	return fmt.Sprintf("/Groups/%s", url.PathEscape(id))
+}
@@ -0,0 +1,174 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scim
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+const UserSchema = "urn:ietf:params:scim:schemas:core:2.0:User"
+
+type Name struct {
+	Formatted       string `json:"formatted,omitempty"`
+	FamilyName      string `json:"familyName,omitempty"`
+	GivenName       string `json:"givenName,omitempty"`
+	MiddleName      string `json:"middleName,omitempty"`
+	HonorificPrefix string `json:"honorificPrefix,omitempty"`
+	HonorificSuffix string `json:"honorificSuffix,omitempty"`
+}
+
+// MultiValue is an item of the multi-valued attributes of a user, like its emails or phone numbers.
+type MultiValue struct {
+	Value   string `json:"value"`
+	Display string `json:"display,omitempty"`
+	Type    string `json:"type,omitempty"`
+	Primary bool   `json:"primary,omitempty"`
+}
+
+type Address struct {
+	Formatted     string `json:"formatted,omitempty"`
+	StreetAddress string `json:"streetAddress,omitempty"`
+	Locality      string `json:"locality,omitempty"`
+	Region        string `json:"region,omitempty"`
+	PostalCode    string `json:"postalCode,omitempty"`
+	Country       string `json:"country,omitempty"`
+	Type          string `json:"type,omitempty"`
+	Primary       bool   `json:"primary,omitempty"`
+}
+
+// GroupRef is a group a user belongs to.
+type GroupRef struct {
+	Value   string `json:"value"`
+	Ref     string `json:"$ref,omitempty"`
+	Display string `json:"display,omitempty"`
+}
+
+// User is the SCIM core user resource, see https://datatracker.ietf.org/doc/html/rfc7643#section-4.1
+type User struct {
+	Schemas    []string `json:"schemas"`
+	Id         string   `json:"id,omitempty"`
+	ExternalId string   `json:"externalId,omitempty"`
+	Meta       *Meta    `json:"meta,omitempty"`
+
+	UserName          string       `json:"userName"`
+	Name              *Name        `json:"name,omitempty"`
+	DisplayName       string       `json:"displayName,omitempty"`
+	NickName          string       `json:"nickName,omitempty"`
+	ProfileUrl        string       `json:"profileUrl,omitempty"`
+	Title             string       `json:"title,omitempty"`
+	UserType          string       `json:"userType,omitempty"`
+	PreferredLanguage string       `json:"preferredLanguage,omitempty"`
+	Locale            string       `json:"locale,omitempty"`
+	Timezone          string       `json:"timezone,omitempty"`
+	Active            *bool        `json:"active,omitempty"`
+	Password          string       `json:"password,omitempty"`
+	Emails            []MultiValue `json:"emails,omitempty"`
+	PhoneNumbers      []MultiValue `json:"phoneNumbers,omitempty"`
+	Photos            []MultiValue `json:"photos,omitempty"`
+	Addresses         []Address    `json:"addresses,omitempty"`
+	Groups            []GroupRef   `json:"groups,omitempty"`
+}
+
+// ListUsers lists the users matching options, which can be nil to list the first page of all users.
+func (c *Client) ListUsers(options *ListOptions) (*ListResponse[*User], error) {
+	return c.ListUsersWithContext(context.Background(), options)
+}
+
+func (c *Client) ListUsersWithContext(ctx context.Context, options *ListOptions) (*ListResponse[*User], error) {
+	var res ListResponse[*User]
+	err := c.do(ctx, http.MethodGet, "/Users"+options.query(), nil, &res)
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+func (c *Client) GetUser(id string) (*User, error) {
+	return c.GetUserWithContext(context.Background(), id)
+}
+
+func (c *Client) GetUserWithContext(ctx context.Context, id string) (*User, error) {
+	var user User
+	err := c.do(ctx, http.MethodGet, getUserPath(id), nil, &user)
+	if err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+// CreateUser creates user and returns it as created by the server.
+func (c *Client) CreateUser(user *User) (*User, error) {
+	return c.CreateUserWithContext(context.Background(), user)
+}
+
+func (c *Client) CreateUserWithContext(ctx context.Context, user *User) (*User, error) {
+	if len(user.Schemas) == 0 {
+		user.Schemas = []string{UserSchema}
+	}
+
+	var res User
+	err := c.do(ctx, http.MethodPost, "/Users", user, &res)
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// ReplaceUser replaces all the attributes of the user with id by the ones of user.
+func (c *Client) ReplaceUser(id string, user *User) (*User, error) {
+	return c.ReplaceUserWithContext(context.Background(), id, user)
+}
+
+func (c *Client) ReplaceUserWithContext(ctx context.Context, id string, user *User) (*User, error) {
+	if len(user.Schemas) == 0 {
+		user.Schemas = []string{UserSchema}
+	}
+
+	var res User
+	err := c.do(ctx, http.MethodPut, getUserPath(id), user, &res)
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// PatchUser applies operations to the user with id, e.g. {Op: "replace", Path: "active", Value: false}.
+func (c *Client) PatchUser(id string, operations ...PatchOperation) (*User, error) {
+	return c.PatchUserWithContext(context.Background(), id, operations...)
+}
+
+func (c *Client) PatchUserWithContext(ctx context.Context, id string, operations ...PatchOperation) (*User, error) {
+	var res User
+	err := c.patch(ctx, getUserPath(id), operations, &res)
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+func (c *Client) DeleteUser(id string) error {
+	return c.DeleteUserWithContext(context.Background(), id)
+}
+
+func (c *Client) DeleteUserWithContext(ctx context.Context, id string) error {
+	return c.do(ctx, http.MethodDelete, getUserPath(id), nil, nil)
+}
+
+func getUserPath(id string) string {
+	return fmt.Sprintf("/Users/%s", url.PathEscape(id))
+}
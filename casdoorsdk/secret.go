@@ -0,0 +1,100 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import (
+	"sync"
+	"time"
+)
+
+// SecretProvider supplies the client secret when a request is sent, e.g. by fetching it from Vault or a KMS,
+// for organizations whose policies forbid passing the plaintext secret to InitConfig.
+type SecretProvider interface {
+	GetClientSecret() (string, error)
+}
+
+// SecretProviderFunc adapts an ordinary function to a SecretProvider.
+type SecretProviderFunc func() (string, error)
+
+func (f SecretProviderFunc) GetClientSecret() (string, error) {
+	return f()
+}
+
+// SetSecretProvider makes the SDK get the client secret from provider instead of the configured ClientSecret.
+// Pass nil to use the configured ClientSecret again.
+func (c *Client) SetSecretProvider(provider SecretProvider) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.secretProvider = provider
+}
+
+// CachedSecretProvider caches the secret of another SecretProvider and fetches it again once it expires.
+type CachedSecretProvider struct {
+	provider SecretProvider
+	ttl      time.Duration
+
+	mutex     sync.Mutex
+	secret    string
+	expiresAt time.Time
+}
+
+// NewCachedSecretProvider returns a SecretProvider that asks provider for the secret at most once per ttl.
+func NewCachedSecretProvider(provider SecretProvider, ttl time.Duration) *CachedSecretProvider {
+	return &CachedSecretProvider{
+		provider: provider,
+		ttl:      ttl,
+	}
+}
+
+func (p *CachedSecretProvider) GetClientSecret() (string, error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if p.secret != "" && time.Now().Before(p.expiresAt) {
+		return p.secret, nil
+	}
+
+	secret, err := p.provider.GetClientSecret()
+	if err != nil {
+		return "", err
+	}
+
+	p.secret = secret
+	p.expiresAt = time.Now().Add(p.ttl)
+	return secret, nil
+}
+
+// Invalidate drops the cached secret, e.g. after it was rotated, so that the next request fetches it again.
+func (p *CachedSecretProvider) Invalidate() {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	p.secret = ""
+}
+
+// getClientSecret returns the client secret to authenticate the requests with.
+func (c *Client) getClientSecret() (string, error) {
+	authConfig := c.getAuthConfig()
+
+	c.mu.RLock()
+	provider := c.secretProvider
+	c.mu.RUnlock()
+
+	if provider == nil {
+		return authConfig.ClientSecret, nil
+	}
+	return provider.GetClientSecret()
+}
@@ -0,0 +1,184 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+const (
+	// serviceTokenRenewRatio is the part of the lifetime of the service token after which it gets renewed.
+	serviceTokenRenewRatio = 0.8
+	// serviceTokenDefaultRenewDelay is when a service token without expiry gets renewed.
+	serviceTokenDefaultRenewDelay = 30 * time.Minute
+	// serviceTokenFetchTimeout bounds the fetches of the service token, which are shared by the requests.
+	serviceTokenFetchTimeout = 30 * time.Second
+)
+
+// serviceTokenCache caches the token that authenticates the API requests in the service token mode.
+type serviceTokenCache struct {
+	client *Client
+
+	// mutex serializes the renewals, so that concurrent requests don't all hit the token endpoint.
+	mutex     sync.Mutex
+	token     *oauth2.Token
+	renewAt   time.Time
+	renewing  bool
+	renewDone chan struct{}
+	// renewErr is the error of the last renewal.
+	renewErr error
+}
+
+// EnableServiceTokenAuth makes the SDK authenticate its API requests with a Bearer token of the application,
+// obtained through the client credentials grant, instead of sending the client secret with every request.
+// The token is cached and renewed in the background once 80% of its lifetime has elapsed.
+func (c *Client) EnableServiceTokenAuth(enabled bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if enabled {
+		c.serviceTokens = &serviceTokenCache{client: c}
+	} else {
+		c.serviceTokens = nil
+	}
+}
+
+func (c *Client) getServiceTokens() *serviceTokenCache {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.serviceTokens
+}
+
+// get returns a valid token. When there is no unexpired one, it waits for the renewal of the token, or until ctx
+// is done. The renewal isn't canceled with ctx, since the other requests wait for it too.
+func (t *serviceTokenCache) get(ctx context.Context) (*oauth2.Token, error) {
+	t.mutex.Lock()
+	if t.token != nil && t.token.Valid() {
+		if !t.renewing && time.Now().After(t.renewAt) {
+			t.startRenewal()
+		}
+		token := t.token
+		t.mutex.Unlock()
+		return token, nil
+	}
+
+	if !t.renewing {
+		t.startRenewal()
+	}
+	done := t.renewDone
+	t.mutex.Unlock()
+
+	select {
+	case <-done:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if t.token != nil && t.token.Valid() {
+		return t.token, nil
+	}
+	return nil, t.renewErr
+}
+
+// startRenewal must be called with the mutex locked.
+func (t *serviceTokenCache) startRenewal() {
+	t.renewing = true
+	t.renewDone = make(chan struct{})
+	go t.renew()
+}
+
+func (t *serviceTokenCache) renew() {
+	ctx, cancel := context.WithTimeout(context.Background(), serviceTokenFetchTimeout)
+	defer cancel()
+	token, err := t.client.GetClientCredentialsTokenWithContext(ctx, "")
+
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	// on failure, the current token keeps being used and the renewal is retried by the next request
+	if err == nil {
+		t.set(token)
+	}
+	t.renewErr = err
+	t.renewing = false
+	close(t.renewDone)
+}
+
+func (t *serviceTokenCache) set(token *oauth2.Token) {
+	t.token = token
+	if token.Expiry.IsZero() {
+		// renewing it on every request would flood the token endpoint
+		t.renewAt = time.Now().Add(serviceTokenDefaultRenewDelay)
+		return
+	}
+	t.renewAt = time.Now().Add(time.Duration(float64(time.Until(token.Expiry)) * serviceTokenRenewRatio))
+}
+
+// getOAuthContext returns ctx carrying an http Client sending the requests of the oauth2 package through the
+// http client of c, with its hooks and logger, so that the token requests get the same treatment as the others.
+func (c *Client) getOAuthContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, oauth2.HTTPClient, &http.Client{Transport: &oauthTransport{client: c}})
+}
+
+// oauthTransport sends the requests of the oauth2 package with the http client of a Client.
+type oauthTransport struct {
+	client *Client
+}
+
+func (t *oauthTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// a RoundTripper must not modify the request, while the hooks may
+	req = req.Clone(req.Context())
+
+	requestHooks, responseHooks := t.client.getHooks()
+	err := callRequestHooks(requestHooks, req)
+	if err != nil {
+		return nil, err
+	}
+
+	logger := t.client.getLogger()
+	start := time.Now()
+	resp, err := t.client.getHttpClient().Do(req)
+	if err != nil {
+		duration := time.Since(start)
+		callResponseHooks(responseHooks, req, nil, nil, err, duration)
+		logRequest(logger, req, nil, err, duration)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := readBody(resp)
+	duration := time.Since(start)
+	if err != nil {
+		callResponseHooks(responseHooks, req, nil, nil, err, duration)
+		logRequest(logger, req, nil, err, duration)
+		return nil, err
+	}
+
+	callResponseHooks(responseHooks, req, resp, body, nil, duration)
+	logRequest(logger, req, resp, nil, duration)
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+	return resp, nil
+}
@@ -0,0 +1,117 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// countingHttpClient is an HttpClient that isn't an *http.Client, counting the requests it sends
+// to the API and token endpoints.
+type countingHttpClient struct {
+	requests int32
+}
+
+func (c *countingHttpClient) Do(req *http.Request) (*http.Response, error) {
+	if !isDiscoveryRequest(req) {
+		atomic.AddInt32(&c.requests, 1)
+	}
+	return http.DefaultClient.Do(req)
+}
+
+// isDiscoveryRequest reports whether req fetches the discovery document, which is done in the background.
+func isDiscoveryRequest(req *http.Request) bool {
+	return req.URL.Path == "/.well-known/openid-configuration"
+}
+
+// newServiceTokenServer returns a server answering the requests authenticated with a service token with
+// the user named "alice", and calling tokenHandler for the token requests.
+func newServiceTokenServer(t *testing.T, tokenHandler http.HandlerFunc) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/login/oauth/access_token" {
+			tokenHandler(w, r)
+			return
+		}
+		if r.Header.Get("Authorization") != "Bearer service-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`{"owner":"built-in","name":"alice"}`))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func writeServiceToken(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write([]byte(`{"access_token":"service-token","token_type":"Bearer","expires_in":3600}`))
+}
+
+func TestServiceTokenGoesThroughTheHttpClientAndHooks(t *testing.T) {
+	server := newServiceTokenServer(t, writeServiceToken)
+	client := NewClient(&AuthConfig{Endpoint: server.URL, ClientId: "client-id", ClientSecret: "client-secret"})
+	httpClient := &countingHttpClient{}
+	client.SetHttpClient(httpClient)
+	var hookedRequests int32
+	client.OnRequest(func(req *http.Request) error {
+		if !isDiscoveryRequest(req) {
+			atomic.AddInt32(&hookedRequests, 1)
+		}
+		return nil
+	})
+	client.EnableServiceTokenAuth(true)
+
+	_, err := client.GetUser("alice")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := atomic.LoadInt32(&httpClient.requests); n != 2 {
+		t.Errorf("the http client sent %d requests, want the token request and the user one", n)
+	}
+	if n := atomic.LoadInt32(&hookedRequests); n != 2 {
+		t.Errorf("the request hooks were called %d times, want 2", n)
+	}
+}
+
+func TestServiceTokenWaitIsBoundedByTheContext(t *testing.T) {
+	blocked := make(chan struct{})
+	defer close(blocked)
+	server := newServiceTokenServer(t, func(w http.ResponseWriter, r *http.Request) {
+		<-blocked
+	})
+	client := NewClient(&AuthConfig{Endpoint: server.URL, ClientId: "client-id", ClientSecret: "client-secret"})
+	client.EnableServiceTokenAuth(true)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	_, err := client.GetUserWithContext(ctx, "alice")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("got error %v, want a deadline exceeded", err)
+	}
+
+	ctx = WithCallOptions(context.Background(), WithTimeout(100*time.Millisecond))
+	_, err = client.GetUserWithContext(ctx, "alice")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("got error %v with a call timeout, want a deadline exceeded", err)
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import (
+	"context"
+	"sync"
+)
+
+// singleFlight shares the result of a call among the callers asking for the same key while it is in flight,
+// so that e.g. the requests needing a document that isn't cached yet fetch it once, without holding a lock
+// of the cache during the fetch.
+type singleFlight[T any] struct {
+	mu    sync.Mutex
+	calls map[string]*flightCall[T]
+}
+
+type flightCall[T any] struct {
+	done  chan struct{}
+	value T
+	err   error
+}
+
+// do calls fn, unless a call with key is in flight, in which case it waits for it and returns its result,
+// or until ctx is done.
+func (f *singleFlight[T]) do(ctx context.Context, key string, fn func() (T, error)) (T, error) {
+	f.mu.Lock()
+	if call, ok := f.calls[key]; ok {
+		f.mu.Unlock()
+		select {
+		case <-call.done:
+			return call.value, call.err
+		case <-ctx.Done():
+			var zero T
+			return zero, ctx.Err()
+		}
+	}
+
+	call := &flightCall[T]{done: make(chan struct{})}
+	if f.calls == nil {
+		f.calls = map[string]*flightCall[T]{}
+	}
+	f.calls[key] = call
+	f.mu.Unlock()
+
+	call.value, call.err = fn()
+	close(call.done)
+
+	f.mu.Lock()
+	delete(f.calls, key)
+	f.mu.Unlock()
+
+	return call.value, call.err
+}
@@ -15,8 +15,9 @@
 package casdoorsdk
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
+	"errors"
 )
 
 type smsForm struct {
@@ -24,7 +25,17 @@ type smsForm struct {
 	Receivers []string `json:"receivers"`
 }
 
-func SendSms(content string, receivers ...string) error {
+// SendSms sends an SMS to the phone numbers in receivers through the SMS provider of the application.
+// If some receivers aren't valid phone numbers, the error is an APIError with the ErrorCodeInvalidPhone code.
+func (c *Client) SendSms(content string, receivers ...string) error {
+	return c.SendSmsWithContext(context.Background(), content, receivers...)
+}
+
+func (c *Client) SendSmsWithContext(ctx context.Context, content string, receivers ...string) error {
+	if len(receivers) == 0 {
+		return errors.New("casdoor: the SMS has no receivers")
+	}
+
 	form := smsForm{
 		Content:   content,
 		Receivers: receivers,
@@ -34,14 +45,6 @@ func SendSms(content string, receivers ...string) error {
 		return err
 	}
 
-	resp, err := DoPost("send-sms", nil, postBytes, false, false)
-	if err != nil {
-		return err
-	}
-
-	if resp.Status != "ok" {
-		return fmt.Errorf(resp.Msg)
-	}
-
-	return nil
+	_, err = c.DoPostWithContext(ctx, "send-sms", nil, postBytes, false, false)
+	return err
 }
@@ -0,0 +1,98 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// stateTtl is how long a state generated by GenerateState is accepted by ValidateState.
+const stateTtl = 10 * time.Minute
+
+// ErrInvalidState is returned by ValidateState when the state doesn't match the session, was forged or has expired.
+var ErrInvalidState = errors.New("casdoor: invalid state")
+
+// GenerateState returns a state for the authorization request, protecting the redirect to the callback against CSRF,
+// and the value to bind it to the session of the user, e.g. in a cookie. The state is signed with the client secret
+// and expires after 10 minutes. Pass it to GetSigninUrlWithState, and check it in the callback with ValidateState.
+func (c *Client) GenerateState() (state string, cookieValue string, err error) {
+	nonce := make([]byte, 16)
+	_, err = rand.Read(nonce)
+	if err != nil {
+		return "", "", err
+	}
+
+	cookieValue = base64.RawURLEncoding.EncodeToString(nonce)
+	payload := cookieValue + "." + strconv.FormatInt(time.Now().Add(stateTtl).Unix(), 10)
+
+	key, err := c.getStateKey()
+	if err != nil {
+		return "", "", err
+	}
+	return payload + "." + signHmacSha256(key, []byte(payload)), cookieValue, nil
+}
+
+// ValidateState checks the state received by the callback against the value that GenerateState returned with it,
+// and returns an error wrapping ErrInvalidState if it doesn't match, has been tampered with or has expired.
+func (c *Client) ValidateState(state string, cookieValue string) error {
+	parts := strings.Split(state, ".")
+	if len(parts) != 3 {
+		return fmt.Errorf("%w: malformed state", ErrInvalidState)
+	}
+
+	key, err := c.getStateKey()
+	if err != nil {
+		return err
+	}
+	if !verifyHmacSha256(key, []byte(parts[0]+"."+parts[1]), parts[2]) {
+		return fmt.Errorf("%w: bad signature", ErrInvalidState)
+	}
+
+	if !secureCompare(parts[0], cookieValue) {
+		return fmt.Errorf("%w: the state doesn't belong to the session", ErrInvalidState)
+	}
+
+	expiresAt, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return fmt.Errorf("%w: malformed expiry", ErrInvalidState)
+	}
+	if time.Now().Unix() > expiresAt {
+		return fmt.Errorf("%w: the state has expired", ErrInvalidState)
+	}
+	return nil
+}
+
+// GetSigninUrlWithState is like GetSigninUrl, with a state generated by GenerateState instead of the application name.
+func (c *Client) GetSigninUrlWithState(redirectUri string, state string) string {
+	return c.getSigninUrl(redirectUri, state)
+}
+
+// getStateKey returns the key the states are signed with, which is the client secret.
+func (c *Client) getStateKey() ([]byte, error) {
+	clientSecret, err := c.getClientSecret()
+	if err != nil {
+		return nil, err
+	}
+	if clientSecret == "" {
+		return nil, fmt.Errorf("the client secret is needed to sign the state")
+	}
+	return []byte(clientSecret), nil
+}
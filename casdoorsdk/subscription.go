@@ -0,0 +1,198 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Subscription has the same definition as https://github.com/casdoor/casdoor/blob/master/object/subscription.go#L36
+type Subscription struct {
+	Owner       string `xorm:"varchar(100) notnull pk" json:"owner"`
+	Name        string `xorm:"varchar(100) notnull pk" json:"name"`
+	CreatedTime string `xorm:"varchar(100)" json:"createdTime"`
+	DisplayName string `xorm:"varchar(100)" json:"displayName"`
+	Duration    int    `json:"duration"`
+
+	Description string    `xorm:"varchar(100)" json:"description"`
+	Plan        string    `xorm:"varchar(100)" json:"plan"`
+	StartDate   time.Time `json:"startDate"`
+	EndDate     time.Time `json:"endDate"`
+
+	User      string `xorm:"mediumtext" json:"user"`
+	IsEnabled bool   `json:"isEnabled"`
+
+	Submitter   string `xorm:"varchar(100)" json:"submitter"`
+	Approver    string `xorm:"varchar(100)" json:"approver"`
+	ApproveTime string `xorm:"varchar(100)" json:"approveTime"`
+
+	State string `xorm:"varchar(100)" json:"state"`
+}
+
+// IsActive reports whether the subscription is enabled, approved and not expired, i.e. whether its user
+// is entitled to its plan now. A zero StartDate or EndDate leaves the subscription open on that side.
+func (subscription *Subscription) IsActive() bool {
+	if !subscription.IsEnabled {
+		return false
+	}
+	if subscription.State != "Approved" && subscription.State != "Active" {
+		return false
+	}
+
+	now := time.Now()
+	if !subscription.StartDate.IsZero() && now.Before(subscription.StartDate) {
+		return false
+	}
+	if !subscription.EndDate.IsZero() && !now.Before(subscription.EndDate) {
+		return false
+	}
+	return true
+}
+
+func (c *Client) GetSubscriptions() ([]*Subscription, error) {
+	return c.GetSubscriptionsWithContext(context.Background())
+}
+
+func (c *Client) GetSubscriptionsWithContext(ctx context.Context) ([]*Subscription, error) {
+	authConfig := c.getAuthConfig()
+
+	queryMap := map[string]string{
+		"owner": authConfig.OrganizationName,
+	}
+
+	url := c.GetUrl("get-subscriptions", queryMap)
+
+	bytes, err := c.DoGetBytesWithContext(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	var subscriptions []*Subscription
+	err = c.unmarshal(bytes, &subscriptions)
+	if err != nil {
+		return nil, err
+	}
+	return subscriptions, nil
+}
+
+// GetSubscriptionsByUser returns the subscriptions of the user named userName.
+func (c *Client) GetSubscriptionsByUser(userName string) ([]*Subscription, error) {
+	return c.GetSubscriptionsByUserWithContext(context.Background(), userName)
+}
+
+func (c *Client) GetSubscriptionsByUserWithContext(ctx context.Context, userName string) ([]*Subscription, error) {
+	subscriptions, err := c.GetSubscriptionsWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := subscriptions[:0]
+	for _, subscription := range subscriptions {
+		if subscription.User == userName {
+			res = append(res, subscription)
+		}
+	}
+	return res, nil
+}
+
+func (c *Client) GetPaginationSubscriptions(p int, pageSize int, queryMap map[string]string) ([]*Subscription, int, error) {
+	return c.GetPaginationSubscriptionsWithContext(context.Background(), p, pageSize, queryMap)
+}
+
+func (c *Client) GetPaginationSubscriptionsWithContext(ctx context.Context, p int, pageSize int, queryMap map[string]string) ([]*Subscription, int, error) {
+	authConfig := c.getAuthConfig()
+
+	queryMap = getPaginationQueryMap(authConfig.OrganizationName, p, pageSize, queryMap)
+
+	url := c.GetUrl("get-subscriptions", queryMap)
+
+	response, err := c.DoGetResponseWithContext(ctx, url)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	bytes, err := json.Marshal(response.Data)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var subscriptions []*Subscription
+	err = c.unmarshal(bytes, &subscriptions)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	count, err := getCount(response)
+	if err != nil {
+		return nil, 0, err
+	}
+	return subscriptions, count, nil
+}
+
+func (c *Client) GetSubscription(name string) (*Subscription, error) {
+	return c.GetSubscriptionWithContext(context.Background(), name)
+}
+
+func (c *Client) GetSubscriptionWithContext(ctx context.Context, name string) (*Subscription, error) {
+	authConfig := c.getAuthConfig()
+
+	queryMap := map[string]string{
+		"id": fmt.Sprintf("%s/%s", authConfig.OrganizationName, name),
+	}
+
+	url := c.GetUrl("get-subscription", queryMap)
+
+	bytes, err := c.DoGetBytesWithContext(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	var subscription *Subscription
+	err = c.unmarshal(bytes, &subscription)
+	if err != nil {
+		return nil, err
+	}
+	return subscription, nil
+}
+
+func (c *Client) UpdateSubscription(subscription *Subscription) (bool, error) {
+	return c.UpdateSubscriptionWithContext(context.Background(), subscription)
+}
+
+func (c *Client) UpdateSubscriptionWithContext(ctx context.Context, subscription *Subscription) (bool, error) {
+	_, affected, err := c.modifySubscription(ctx, "update-subscription", subscription, nil)
+	return affected, err
+}
+
+func (c *Client) AddSubscription(subscription *Subscription) (bool, error) {
+	return c.AddSubscriptionWithContext(context.Background(), subscription)
+}
+
+func (c *Client) AddSubscriptionWithContext(ctx context.Context, subscription *Subscription) (bool, error) {
+	_, affected, err := c.modifySubscription(ctx, "add-subscription", subscription, nil)
+	return affected, err
+}
+
+func (c *Client) DeleteSubscription(subscription *Subscription) (bool, error) {
+	return c.DeleteSubscriptionWithContext(context.Background(), subscription)
+}
+
+func (c *Client) DeleteSubscriptionWithContext(ctx context.Context, subscription *Subscription) (bool, error) {
+	_, affected, err := c.modifySubscription(ctx, "delete-subscription", subscription, nil)
+	return affected, err
+}
@@ -0,0 +1,73 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import "context"
+
+func GetSubscriptions() ([]*Subscription, error) {
+	return globalClient.GetSubscriptions()
+}
+
+func GetSubscriptionsWithContext(ctx context.Context) ([]*Subscription, error) {
+	return globalClient.GetSubscriptionsWithContext(ctx)
+}
+
+func GetSubscriptionsByUser(userName string) ([]*Subscription, error) {
+	return globalClient.GetSubscriptionsByUser(userName)
+}
+
+func GetSubscriptionsByUserWithContext(ctx context.Context, userName string) ([]*Subscription, error) {
+	return globalClient.GetSubscriptionsByUserWithContext(ctx, userName)
+}
+
+func GetPaginationSubscriptions(p int, pageSize int, queryMap map[string]string) ([]*Subscription, int, error) {
+	return globalClient.GetPaginationSubscriptions(p, pageSize, queryMap)
+}
+
+func GetPaginationSubscriptionsWithContext(ctx context.Context, p int, pageSize int, queryMap map[string]string) ([]*Subscription, int, error) {
+	return globalClient.GetPaginationSubscriptionsWithContext(ctx, p, pageSize, queryMap)
+}
+
+func GetSubscription(name string) (*Subscription, error) {
+	return globalClient.GetSubscription(name)
+}
+
+func GetSubscriptionWithContext(ctx context.Context, name string) (*Subscription, error) {
+	return globalClient.GetSubscriptionWithContext(ctx, name)
+}
+
+func UpdateSubscription(subscription *Subscription) (bool, error) {
+	return globalClient.UpdateSubscription(subscription)
+}
+
+func UpdateSubscriptionWithContext(ctx context.Context, subscription *Subscription) (bool, error) {
+	return globalClient.UpdateSubscriptionWithContext(ctx, subscription)
+}
+
+func AddSubscription(subscription *Subscription) (bool, error) {
+	return globalClient.AddSubscription(subscription)
+}
+
+func AddSubscriptionWithContext(ctx context.Context, subscription *Subscription) (bool, error) {
+	return globalClient.AddSubscriptionWithContext(ctx, subscription)
+}
+
+func DeleteSubscription(subscription *Subscription) (bool, error) {
+	return globalClient.DeleteSubscription(subscription)
+}
+
+func DeleteSubscriptionWithContext(ctx context.Context, subscription *Subscription) (bool, error) {
+	return globalClient.DeleteSubscriptionWithContext(ctx, subscription)
+}
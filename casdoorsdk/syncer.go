@@ -0,0 +1,196 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+type TableColumn struct {
+	Name        string   `json:"name"`
+	Type        string   `json:"type"`
+	CasdoorName string   `json:"casdoorName"`
+	IsKey       bool     `json:"isKey"`
+	IsHashed    bool     `json:"isHashed"`
+	Values      []string `json:"values"`
+}
+
+// Syncer has the same definition as https://github.com/casdoor/casdoor/blob/master/object/syncer.go#L40
+type Syncer struct {
+	Owner       string `xorm:"varchar(100) notnull pk" json:"owner"`
+	Name        string `xorm:"varchar(100) notnull pk" json:"name"`
+	CreatedTime string `xorm:"varchar(100)" json:"createdTime"`
+
+	Organization string `xorm:"varchar(100)" json:"organization"`
+	Type         string `xorm:"varchar(100)" json:"type"`
+
+	Host             string         `xorm:"varchar(100)" json:"host"`
+	Port             int            `json:"port"`
+	User             string         `xorm:"varchar(100)" json:"user"`
+	Password         string         `xorm:"varchar(100)" json:"password"`
+	DatabaseType     string         `xorm:"varchar(100)" json:"databaseType"`
+	Database         string         `xorm:"varchar(100)" json:"database"`
+	Table            string         `xorm:"varchar(100)" json:"table"`
+	TablePrimaryKey  string         `xorm:"varchar(100)" json:"tablePrimaryKey"`
+	TableColumns     []*TableColumn `xorm:"mediumtext" json:"tableColumns"`
+	AffiliationTable string         `xorm:"varchar(100)" json:"affiliationTable"`
+	AvatarBaseUrl    string         `xorm:"varchar(100)" json:"avatarBaseUrl"`
+	ErrorText        string         `xorm:"mediumtext" json:"errorText"`
+	SyncInterval     int            `json:"syncInterval"`
+	IsReadOnly       bool           `json:"isReadOnly"`
+	IsEnabled        bool           `json:"isEnabled"`
+}
+
+// GetSyncers returns the syncers of the configured organization.
+func (c *Client) GetSyncers() ([]*Syncer, error) {
+	return c.GetSyncersWithContext(context.Background())
+}
+
+func (c *Client) GetSyncersWithContext(ctx context.Context) ([]*Syncer, error) {
+	authConfig := c.getAuthConfig()
+
+	queryMap := map[string]string{
+		"owner":        "admin",
+		"organization": authConfig.OrganizationName,
+	}
+
+	url := c.GetUrl("get-syncers", queryMap)
+
+	bytes, err := c.DoGetBytesWithContext(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	var syncers []*Syncer
+	err = c.unmarshal(bytes, &syncers)
+	if err != nil {
+		return nil, err
+	}
+	return syncers, nil
+}
+
+func (c *Client) GetPaginationSyncers(p int, pageSize int, queryMap map[string]string) ([]*Syncer, int, error) {
+	return c.GetPaginationSyncersWithContext(context.Background(), p, pageSize, queryMap)
+}
+
+func (c *Client) GetPaginationSyncersWithContext(ctx context.Context, p int, pageSize int, queryMap map[string]string) ([]*Syncer, int, error) {
+	authConfig := c.getAuthConfig()
+
+	queryMap = getPaginationQueryMap("admin", p, pageSize, queryMap)
+	if _, ok := queryMap["organization"]; !ok {
+		queryMap["organization"] = authConfig.OrganizationName
+	}
+
+	url := c.GetUrl("get-syncers", queryMap)
+
+	response, err := c.DoGetResponseWithContext(ctx, url)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	bytes, err := json.Marshal(response.Data)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var syncers []*Syncer
+	err = c.unmarshal(bytes, &syncers)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	count, err := getCount(response)
+	if err != nil {
+		return nil, 0, err
+	}
+	return syncers, count, nil
+}
+
+func (c *Client) GetSyncer(name string) (*Syncer, error) {
+	return c.GetSyncerWithContext(context.Background(), name)
+}
+
+func (c *Client) GetSyncerWithContext(ctx context.Context, name string) (*Syncer, error) {
+	queryMap := map[string]string{
+		"id": fmt.Sprintf("admin/%s", name),
+	}
+
+	url := c.GetUrl("get-syncer", queryMap)
+
+	bytes, err := c.DoGetBytesWithContext(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	var syncer *Syncer
+	err = c.unmarshal(bytes, &syncer)
+	if err != nil {
+		return nil, err
+	}
+	return syncer, nil
+}
+
+func (c *Client) UpdateSyncer(syncer *Syncer) (bool, error) {
+	return c.UpdateSyncerWithContext(context.Background(), syncer)
+}
+
+func (c *Client) UpdateSyncerWithContext(ctx context.Context, syncer *Syncer) (bool, error) {
+	_, affected, err := c.modifySyncer(ctx, "update-syncer", syncer)
+	return affected, err
+}
+
+func (c *Client) AddSyncer(syncer *Syncer) (bool, error) {
+	return c.AddSyncerWithContext(context.Background(), syncer)
+}
+
+func (c *Client) AddSyncerWithContext(ctx context.Context, syncer *Syncer) (bool, error) {
+	_, affected, err := c.modifySyncer(ctx, "add-syncer", syncer)
+	return affected, err
+}
+
+func (c *Client) DeleteSyncer(name string) (bool, error) {
+	return c.DeleteSyncerWithContext(context.Background(), name)
+}
+
+func (c *Client) DeleteSyncerWithContext(ctx context.Context, name string) (bool, error) {
+	syncer := Syncer{
+		Owner: "admin",
+		Name:  name,
+	}
+	_, affected, err := c.modifySyncer(ctx, "delete-syncer", &syncer)
+	return affected, err
+}
+
+// RunSyncer runs the syncer named name once, now. The server returns once the sync is done:
+// the syncer can then be fetched with GetSyncer to check its ErrorText.
+func (c *Client) RunSyncer(name string) (bool, error) {
+	return c.RunSyncerWithContext(context.Background(), name)
+}
+
+func (c *Client) RunSyncerWithContext(ctx context.Context, name string) (bool, error) {
+	queryMap := map[string]string{
+		"id": fmt.Sprintf("admin/%s", name),
+	}
+
+	url := c.GetUrl("run-syncer", queryMap)
+
+	_, err := c.DoGetResponseWithContext(ctx, url)
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
@@ -0,0 +1,73 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import "context"
+
+func GetSyncers() ([]*Syncer, error) {
+	return globalClient.GetSyncers()
+}
+
+func GetSyncersWithContext(ctx context.Context) ([]*Syncer, error) {
+	return globalClient.GetSyncersWithContext(ctx)
+}
+
+func GetPaginationSyncers(p int, pageSize int, queryMap map[string]string) ([]*Syncer, int, error) {
+	return globalClient.GetPaginationSyncers(p, pageSize, queryMap)
+}
+
+func GetPaginationSyncersWithContext(ctx context.Context, p int, pageSize int, queryMap map[string]string) ([]*Syncer, int, error) {
+	return globalClient.GetPaginationSyncersWithContext(ctx, p, pageSize, queryMap)
+}
+
+func GetSyncer(name string) (*Syncer, error) {
+	return globalClient.GetSyncer(name)
+}
+
+func GetSyncerWithContext(ctx context.Context, name string) (*Syncer, error) {
+	return globalClient.GetSyncerWithContext(ctx, name)
+}
+
+func UpdateSyncer(syncer *Syncer) (bool, error) {
+	return globalClient.UpdateSyncer(syncer)
+}
+
+func UpdateSyncerWithContext(ctx context.Context, syncer *Syncer) (bool, error) {
+	return globalClient.UpdateSyncerWithContext(ctx, syncer)
+}
+
+func AddSyncer(syncer *Syncer) (bool, error) {
+	return globalClient.AddSyncer(syncer)
+}
+
+func AddSyncerWithContext(ctx context.Context, syncer *Syncer) (bool, error) {
+	return globalClient.AddSyncerWithContext(ctx, syncer)
+}
+
+func DeleteSyncer(name string) (bool, error) {
+	return globalClient.DeleteSyncer(name)
+}
+
+func DeleteSyncerWithContext(ctx context.Context, name string) (bool, error) {
+	return globalClient.DeleteSyncerWithContext(ctx, name)
+}
+
+func RunSyncer(name string) (bool, error) {
+	return globalClient.RunSyncer(name)
+}
+
+func RunSyncerWithContext(ctx context.Context, name string) (bool, error) {
+	return globalClient.RunSyncerWithContext(ctx, name)
+}
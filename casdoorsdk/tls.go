@@ -0,0 +1,122 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+)
+
+// TLSOptions configures the TLS connections to the server, e.g. to talk to a Casdoor hosted internally
+// behind a private CA or requiring client certificates.
+type TLSOptions struct {
+	// CACertificates are PEM-encoded certificates of CAs trusted in addition to the ones of the system.
+	CACertificates []byte
+	// CAFile is the path of a PEM bundle of CAs trusted in addition to the ones of the system.
+	CAFile string
+
+	// ClientCertificate and ClientKey are the PEM-encoded certificate and key the client authenticates with.
+	ClientCertificate []byte
+	ClientKey         []byte
+	// ClientCertFile and ClientKeyFile are the paths of the PEM-encoded certificate and key
+	// the client authenticates with, if ClientCertificate isn't set.
+	ClientCertFile string
+	ClientKeyFile  string
+
+	// InsecureSkipVerify disables the verification of the certificate of the server. It is only meant for
+	// development, since it makes the connections vulnerable to man-in-the-middle attacks.
+	InsecureSkipVerify bool
+}
+
+// SetTLSOptions makes the http client of the client use options for its TLS connections. The http client is
+// copied with a new transport, so it must be an *http.Client with an *http.Transport, like the default one:
+// use NewTLSConfig to configure the other ones.
+func (c *Client) SetTLSOptions(options TLSOptions) error {
+	tlsConfig, err := NewTLSConfig(options)
+	if err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	httpClient, ok := c.httpClient.(*http.Client)
+	if !ok {
+		return errors.New("casdoor: the TLS options can only be set on an *http.Client, use NewTLSConfig instead")
+	}
+
+	var transport *http.Transport
+	switch t := httpClient.Transport.(type) {
+	case nil:
+		transport = http.DefaultTransport.(*http.Transport).Clone()
+	case *http.Transport:
+		transport = t.Clone()
+	default:
+		return errors.New("casdoor: the TLS options can only be set on an *http.Transport, use NewTLSConfig instead")
+	}
+	transport.TLSClientConfig = tlsConfig
+
+	newHttpClient := *httpClient
+	newHttpClient.Transport = transport
+	c.httpClient = &newHttpClient
+	return nil
+}
+
+// NewTLSConfig returns the tls.Config of options.
+func NewTLSConfig(options TLSOptions) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: options.InsecureSkipVerify,
+	}
+
+	caCertificates := options.CACertificates
+	if options.CAFile != "" {
+		bundle, err := ioutil.ReadFile(options.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("casdoor: failed to read the CA bundle: %w", err)
+		}
+		caCertificates = append(append(caCertificates[:len(caCertificates):len(caCertificates)], '\n'), bundle...)
+	}
+	if len(caCertificates) > 0 {
+		rootCAs, err := x509.SystemCertPool()
+		if err != nil {
+			rootCAs = x509.NewCertPool()
+		}
+		if !rootCAs.AppendCertsFromPEM(caCertificates) {
+			return nil, errors.New("casdoor: no CA certificate could be parsed")
+		}
+		tlsConfig.RootCAs = rootCAs
+	}
+
+	var certificate tls.Certificate
+	var err error
+	switch {
+	case len(options.ClientCertificate) > 0:
+		certificate, err = tls.X509KeyPair(options.ClientCertificate, options.ClientKey)
+	case options.ClientCertFile != "":
+		certificate, err = tls.LoadX509KeyPair(options.ClientCertFile, options.ClientKeyFile)
+	default:
+		return tlsConfig, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("casdoor: failed to load the client certificate: %w", err)
+	}
+	tlsConfig.Certificates = []tls.Certificate{certificate}
+	return tlsConfig, nil
+}
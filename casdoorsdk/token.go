@@ -19,10 +19,10 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"strconv"
 	"strings"
 
 	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
 )
 
 // Token has the same definition as https://github.com/casdoor/casdoor/blob/master/object/token.go#L45
@@ -47,36 +47,65 @@ type Token struct {
 }
 
 // GetOAuthToken gets the pivotal and necessary secret to interact with the Casdoor server
-func GetOAuthToken(code string, state string) (*oauth2.Token, error) {
+func (c *Client) GetOAuthToken(code string, state string) (*oauth2.Token, error) {
+	return c.GetOAuthTokenWithContext(context.Background(), code, state)
+}
+
+func (c *Client) GetOAuthTokenWithContext(ctx context.Context, code string, state string) (*oauth2.Token, error) {
+	return c.exchangeCode(ctx, code)
+}
+
+// exchangeCode exchanges an authorization code for a token at the token endpoint, with the extra opts.
+func (c *Client) exchangeCode(ctx context.Context, code string, opts ...oauth2.AuthCodeOption) (*oauth2.Token, error) {
+	authConfig := c.getAuthConfig()
+
+	clientSecret, err := c.getClientSecret()
+	if err != nil {
+		return nil, err
+	}
+
 	config := oauth2.Config{
 		ClientID:     authConfig.ClientId,
-		ClientSecret: authConfig.ClientSecret,
+		ClientSecret: clientSecret,
 		Endpoint: oauth2.Endpoint{
 			AuthURL:   fmt.Sprintf("%s/api/login/oauth/authorize", authConfig.Endpoint),
-			TokenURL:  fmt.Sprintf("%s/api/login/oauth/access_token", authConfig.Endpoint),
+			TokenURL:  c.getTokenUrl(authConfig.Endpoint),
 			AuthStyle: oauth2.AuthStyleInParams,
 		},
 		// RedirectURL: redirectUri,
 		Scopes: nil,
 	}
 
-	token, err := config.Exchange(context.Background(), code)
+	token, err := config.Exchange(c.getOAuthContext(ctx), code, opts...)
 	if err != nil {
 		return token, err
 	}
 
-	if strings.HasPrefix(token.AccessToken, "error:") {
-		return nil, errors.New(strings.TrimLeft(token.AccessToken, "error: "))
+	err = c.getOAuthTokenError(token)
+	if err != nil {
+		return nil, err
 	}
 
 	return token, err
 }
 
-// RefreshOAuthToken refreshes the OAuth token
-func RefreshOAuthToken(refreshToken string) (*oauth2.Token, error) {
+// RefreshOAuthToken gets a new OAuth token with the refresh_token grant, to renew an expired access token
+// without sending the user through the login flow again.
+func (c *Client) RefreshOAuthToken(refreshToken string) (*oauth2.Token, error) {
+	return c.RefreshOAuthTokenWithContext(context.Background(), refreshToken)
+}
+
+func (c *Client) RefreshOAuthTokenWithContext(ctx context.Context, refreshToken string) (*oauth2.Token, error) {
+	authConfig := c.getAuthConfig()
+
+	clientSecret, err := c.getClientSecret()
+	if err != nil {
+		return nil, err
+	}
+
 	config := oauth2.Config{
 		ClientID:     authConfig.ClientId,
-		ClientSecret: authConfig.ClientSecret,
+		ClientSecret: clientSecret,
 		Endpoint: oauth2.Endpoint{
 			AuthURL:   fmt.Sprintf("%s/api/login/oauth/authorize", authConfig.Endpoint),
 			TokenURL:  fmt.Sprintf("%s/api/login/oauth/refresh_token", authConfig.Endpoint),
@@ -86,28 +115,194 @@ func RefreshOAuthToken(refreshToken string) (*oauth2.Token, error) {
 		Scopes: nil,
 	}
 
-	token, err := config.TokenSource(context.Background(), &oauth2.Token{RefreshToken: refreshToken}).Token()
+	token, err := config.TokenSource(c.getOAuthContext(ctx), &oauth2.Token{RefreshToken: refreshToken}).Token()
 	if err != nil {
 		return token, err
 	}
 
-	if strings.HasPrefix(token.AccessToken, "error:") {
-		return nil, errors.New(strings.TrimLeft(token.AccessToken, "error: "))
+	err = c.getOAuthTokenError(token)
+	if err != nil {
+		return nil, err
 	}
 
 	return token, err
 }
 
-func GetTokens(p int, pageSize int) ([]*Token, int, error) {
-	queryMap := map[string]string{
-		"owner":    authConfig.OrganizationName,
-		"p":        strconv.Itoa(p),
-		"pageSize": strconv.Itoa(pageSize),
+// GetOAuthTokenByPassword exchanges the credentials of a user for a token with the password grant,
+// for the legacy integrations that can't redirect to the login page. A rejected login is an APIError whose Code
+// tells a wrong password (ErrorCodeWrongPassword) from a locked account (ErrorCodeAccountLocked).
+func (c *Client) GetOAuthTokenByPassword(username string, password string) (*oauth2.Token, error) {
+	return c.GetOAuthTokenByPasswordWithContext(context.Background(), username, password)
+}
+
+func (c *Client) GetOAuthTokenByPasswordWithContext(ctx context.Context, username string, password string) (*oauth2.Token, error) {
+	authConfig := c.getAuthConfig()
+
+	clientSecret, err := c.getClientSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	config := oauth2.Config{
+		ClientID:     authConfig.ClientId,
+		ClientSecret: clientSecret,
+		Endpoint: oauth2.Endpoint{
+			AuthURL:   fmt.Sprintf("%s/api/login/oauth/authorize", authConfig.Endpoint),
+			TokenURL:  c.getTokenUrl(authConfig.Endpoint),
+			AuthStyle: oauth2.AuthStyleInParams,
+		},
+	}
+
+	token, err := config.PasswordCredentialsToken(c.getOAuthContext(ctx), username, password)
+	if err != nil {
+		return nil, c.convertRetrieveError(err)
 	}
 
-	url := GetUrl("get-tokens", queryMap)
+	err = c.getOAuthTokenError(token)
+	if err != nil {
+		return nil, err
+	}
+
+	return token, nil
+}
+
+// GetClientCredentialsToken gets a token of the application itself with the client_credentials grant,
+// for the machine-to-machine services calling the APIs protected by Casdoor. scope may be empty.
+func (c *Client) GetClientCredentialsToken(scope string) (*oauth2.Token, error) {
+	return c.GetClientCredentialsTokenWithContext(context.Background(), scope)
+}
+
+func (c *Client) GetClientCredentialsTokenWithContext(ctx context.Context, scope string) (*oauth2.Token, error) {
+	authConfig := c.getAuthConfig()
+
+	clientSecret, err := c.getClientSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	config := clientcredentials.Config{
+		ClientID:     authConfig.ClientId,
+		ClientSecret: clientSecret,
+		TokenURL:     c.getTokenUrl(authConfig.Endpoint),
+		AuthStyle:    oauth2.AuthStyleInParams,
+	}
+	if scope != "" {
+		config.Scopes = strings.Fields(scope)
+	}
+
+	token, err := config.Token(c.getOAuthContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.getOAuthTokenError(token)
+	if err != nil {
+		return nil, err
+	}
+
+	return token, nil
+}
+
+// NewTokenSource returns a TokenSource of token, e.g. to authenticate the calls to other services, renewing it
+// with RefreshOAuthToken once it expires. The token must have a refresh token to be renewed.
+func (c *Client) NewTokenSource(token *oauth2.Token) oauth2.TokenSource {
+	return oauth2.ReuseTokenSource(token, &refreshTokenSource{client: c, refreshToken: token.RefreshToken})
+}
+
+// NewClientCredentialsTokenSource returns a TokenSource of the tokens of the application itself,
+// getting a new one with GetClientCredentialsToken once the current one expires.
+func (c *Client) NewClientCredentialsTokenSource(scope string) oauth2.TokenSource {
+	return oauth2.ReuseTokenSource(nil, tokenSourceFunc(func() (*oauth2.Token, error) {
+		return c.GetClientCredentialsToken(scope)
+	}))
+}
+
+type tokenSourceFunc func() (*oauth2.Token, error)
+
+func (f tokenSourceFunc) Token() (*oauth2.Token, error) {
+	return f()
+}
+
+// refreshTokenSource renews the token, keeping track of the refresh token, which the server may rotate.
+type refreshTokenSource struct {
+	client       *Client
+	refreshToken string
+}
 
-	response, err := DoGetResponse(url)
+// Token is only called by the ReuseTokenSource, which serializes the calls.
+func (s *refreshTokenSource) Token() (*oauth2.Token, error) {
+	token, err := s.client.RefreshOAuthToken(s.refreshToken)
+	if err != nil {
+		return nil, err
+	}
+
+	if token.RefreshToken != "" {
+		s.refreshToken = token.RefreshToken
+	}
+	return token, nil
+}
+
+// getOAuthTokenError returns the APIError of token if its access token is an error message,
+// which is how the server reports some errors of the token endpoint.
+func (c *Client) getOAuthTokenError(token *oauth2.Token) error {
+	if !strings.HasPrefix(token.AccessToken, "error:") {
+		return nil
+	}
+	return c.newAPIError(strings.TrimSpace(strings.TrimPrefix(token.AccessToken, "error:")))
+}
+
+// convertRetrieveError converts the OAuth error response in err, if any, into an APIError.
+func (c *Client) convertRetrieveError(err error) error {
+	var retrieveErr *oauth2.RetrieveError
+	if !errors.As(err, &retrieveErr) {
+		return err
+	}
+
+	var response struct {
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}
+	if json.Unmarshal(retrieveErr.Body, &response) != nil || response.Error == "" {
+		return err
+	}
+
+	apiErr := c.newOAuthError(response.Error, response.ErrorDescription)
+	if response.ErrorDescription != "" {
+		// the description tells more than the generic OAuth error code, like invalid_grant
+		if code := getErrorCode(response.ErrorDescription); code != ErrorCodeUnknown {
+			apiErr.Code = code
+		}
+	}
+	if resp := retrieveErr.Response; resp != nil && resp.Request != nil {
+		apiErr.StatusCode = resp.StatusCode
+		apiErr.Method = resp.Request.Method
+		apiErr.Action = getAction(resp.Request.URL.String())
+		apiErr.Url = RedactUrl(resp.Request.URL.String())
+	}
+	apiErr.Body = RedactBody(retrieveErr.Body)
+	return apiErr
+}
+
+func (c *Client) GetTokens(p int, pageSize int) ([]*Token, int, error) {
+	return c.GetTokensWithContext(context.Background(), p, pageSize)
+}
+
+func (c *Client) GetTokensWithContext(ctx context.Context, p int, pageSize int) ([]*Token, int, error) {
+	return c.GetPaginationTokensWithContext(ctx, p, pageSize, nil)
+}
+
+func (c *Client) GetPaginationTokens(p int, pageSize int, queryMap map[string]string) ([]*Token, int, error) {
+	return c.GetPaginationTokensWithContext(context.Background(), p, pageSize, queryMap)
+}
+
+func (c *Client) GetPaginationTokensWithContext(ctx context.Context, p int, pageSize int, queryMap map[string]string) ([]*Token, int, error) {
+	authConfig := c.getAuthConfig()
+
+	queryMap = getPaginationQueryMap(authConfig.OrganizationName, p, pageSize, queryMap)
+
+	url := c.GetUrl("get-tokens", queryMap)
+
+	response, err := c.DoGetResponseWithContext(ctx, url)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -118,27 +313,86 @@ func GetTokens(p int, pageSize int) ([]*Token, int, error) {
 	}
 
 	var tokens []*Token
-	err = json.Unmarshal(bytes, &tokens)
+	err = c.unmarshal(bytes, &tokens)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	count, err := getCount(response)
 	if err != nil {
 		return nil, 0, err
 	}
-	return tokens, int(response.Data2.(float64)), nil
+	return tokens, count, nil
+}
+
+func (c *Client) GetToken(name string) (*Token, error) {
+	return c.GetTokenWithContext(context.Background(), name)
+}
+
+func (c *Client) GetTokenWithContext(ctx context.Context, name string) (*Token, error) {
+	queryMap := map[string]string{
+		"id": fmt.Sprintf("admin/%s", name),
+	}
+
+	url := c.GetUrl("get-token", queryMap)
+
+	bytes, err := c.DoGetBytesWithContext(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	var token *Token
+	err = c.unmarshal(bytes, &token)
+	if err != nil {
+		return nil, err
+	}
+	return token, nil
+}
+
+func (c *Client) UpdateToken(token *Token) (bool, error) {
+	return c.UpdateTokenWithContext(context.Background(), token)
+}
+
+func (c *Client) UpdateTokenWithContext(ctx context.Context, token *Token) (bool, error) {
+	if token.Owner == "" {
+		token.Owner = "admin"
+	}
+	queryMap := map[string]string{
+		"id": fmt.Sprintf("%s/%s", token.Owner, token.Name),
+	}
+
+	postBytes, err := json.Marshal(token)
+	if err != nil {
+		return false, err
+	}
+
+	resp, err := c.DoPostWithContext(ctx, "update-token", queryMap, postBytes, false, false)
+	if err != nil {
+		return false, err
+	}
+
+	return isAffected(resp), nil
+}
+
+// DeleteToken deletes the token named name, which revokes it.
+func (c *Client) DeleteToken(name string) (bool, error) {
+	return c.DeleteTokenWithContext(context.Background(), name)
 }
 
-func DeleteToken(name string) (bool, error) {
-	organization := Organization{
+func (c *Client) DeleteTokenWithContext(ctx context.Context, name string) (bool, error) {
+	token := Token{
 		Owner: "admin",
 		Name:  name,
 	}
-	postBytes, err := json.Marshal(organization)
+	postBytes, err := json.Marshal(token)
 	if err != nil {
 		return false, err
 	}
 
-	resp, err := DoPost("delete-token", nil, postBytes, false, false)
+	resp, err := c.DoPostWithContext(ctx, "delete-token", nil, postBytes, false, false)
 	if err != nil {
 		return false, err
 	}
 
-	return resp.Data == "Affected", nil
+	return isAffected(resp), nil
 }
@@ -0,0 +1,100 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import (
+	"context"
+	"golang.org/x/oauth2"
+)
+
+func GetOAuthToken(code string, state string) (*oauth2.Token, error) {
+	return globalClient.GetOAuthToken(code, state)
+}
+
+func GetOAuthTokenWithContext(ctx context.Context, code string, state string) (*oauth2.Token, error) {
+	return globalClient.GetOAuthTokenWithContext(ctx, code, state)
+}
+
+func RefreshOAuthToken(refreshToken string) (*oauth2.Token, error) {
+	return globalClient.RefreshOAuthToken(refreshToken)
+}
+
+func RefreshOAuthTokenWithContext(ctx context.Context, refreshToken string) (*oauth2.Token, error) {
+	return globalClient.RefreshOAuthTokenWithContext(ctx, refreshToken)
+}
+
+func GetOAuthTokenByPassword(username string, password string) (*oauth2.Token, error) {
+	return globalClient.GetOAuthTokenByPassword(username, password)
+}
+
+func GetOAuthTokenByPasswordWithContext(ctx context.Context, username string, password string) (*oauth2.Token, error) {
+	return globalClient.GetOAuthTokenByPasswordWithContext(ctx, username, password)
+}
+
+func GetClientCredentialsToken(scope string) (*oauth2.Token, error) {
+	return globalClient.GetClientCredentialsToken(scope)
+}
+
+func GetClientCredentialsTokenWithContext(ctx context.Context, scope string) (*oauth2.Token, error) {
+	return globalClient.GetClientCredentialsTokenWithContext(ctx, scope)
+}
+
+func NewTokenSource(token *oauth2.Token) oauth2.TokenSource {
+	return globalClient.NewTokenSource(token)
+}
+
+func NewClientCredentialsTokenSource(scope string) oauth2.TokenSource {
+	return globalClient.NewClientCredentialsTokenSource(scope)
+}
+
+func GetTokens(p int, pageSize int) ([]*Token, int, error) {
+	return globalClient.GetTokens(p, pageSize)
+}
+
+func GetTokensWithContext(ctx context.Context, p int, pageSize int) ([]*Token, int, error) {
+	return globalClient.GetTokensWithContext(ctx, p, pageSize)
+}
+
+func GetPaginationTokens(p int, pageSize int, queryMap map[string]string) ([]*Token, int, error) {
+	return globalClient.GetPaginationTokens(p, pageSize, queryMap)
+}
+
+func GetPaginationTokensWithContext(ctx context.Context, p int, pageSize int, queryMap map[string]string) ([]*Token, int, error) {
+	return globalClient.GetPaginationTokensWithContext(ctx, p, pageSize, queryMap)
+}
+
+func GetToken(name string) (*Token, error) {
+	return globalClient.GetToken(name)
+}
+
+func GetTokenWithContext(ctx context.Context, name string) (*Token, error) {
+	return globalClient.GetTokenWithContext(ctx, name)
+}
+
+func UpdateToken(token *Token) (bool, error) {
+	return globalClient.UpdateToken(token)
+}
+
+func UpdateTokenWithContext(ctx context.Context, token *Token) (bool, error) {
+	return globalClient.UpdateTokenWithContext(ctx, token)
+}
+
+func DeleteToken(name string) (bool, error) {
+	return globalClient.DeleteToken(name)
+}
+
+func DeleteTokenWithContext(ctx context.Context, name string) (bool, error) {
+	return globalClient.DeleteTokenWithContext(ctx, name)
+}
@@ -15,30 +15,77 @@
 package casdoorsdk
 
 import (
+	"context"
 	"fmt"
 	"net/url"
 	"strings"
 )
 
-func GetSignupUrl(enablePassword bool, redirectUri string) string {
+func (c *Client) GetSignupUrl(enablePassword bool, redirectUri string) string {
+	authConfig := c.getAuthConfig()
+
 	// redirectUri can be empty string if enablePassword == true (only password enabled signup page is required)
 	if enablePassword {
 		return fmt.Sprintf("%s/signup/%s", authConfig.Endpoint, authConfig.ApplicationName)
 	} else {
-		return strings.ReplaceAll(GetSigninUrl(redirectUri), "/login/oauth/authorize", "/signup/oauth/authorize")
+		return strings.ReplaceAll(c.GetSigninUrl(redirectUri), "/login/oauth/authorize", "/signup/oauth/authorize")
 	}
 }
 
-func GetSigninUrl(redirectUri string) string {
+func (c *Client) GetSigninUrl(redirectUri string) string {
+	return c.getSigninUrl(redirectUri, c.getAuthConfig().ApplicationName)
+}
+
+func (c *Client) getSigninUrl(redirectUri string, state string) string {
+	authConfig := c.getAuthConfig()
+
 	// origin := "https://door.casbin.com"
 	// redirectUri := fmt.Sprintf("%s/callback", origin)
 	scope := "read"
-	state := authConfig.ApplicationName
 	return fmt.Sprintf("%s/login/oauth/authorize?client_id=%s&response_type=code&redirect_uri=%s&scope=%s&state=%s",
-		authConfig.Endpoint, authConfig.ClientId, url.QueryEscape(redirectUri), scope, state)
+		authConfig.Endpoint, authConfig.ClientId, url.QueryEscape(redirectUri), scope, url.QueryEscape(state))
+}
+
+// CheckRedirectUri returns an error wrapping ErrInvalidRedirectUri if redirectUri isn't registered in the application,
+// which the server would otherwise only report on its error page. The application is fetched and cached for a few minutes.
+func (c *Client) CheckRedirectUri(redirectUri string) error {
+	return c.CheckRedirectUriWithContext(context.Background(), redirectUri)
+}
+
+func (c *Client) CheckRedirectUriWithContext(ctx context.Context, redirectUri string) error {
+	application, err := c.getCachedApplication(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !application.IsRedirectUriValid(redirectUri) {
+		return fmt.Errorf("%w: %s", ErrInvalidRedirectUri, redirectUri)
+	}
+	return nil
 }
 
-func GetUserProfileUrl(userName string, accessToken string) string {
+// GetCheckedSigninUrl is like GetSigninUrl, but checks redirectUri with CheckRedirectUri first.
+func (c *Client) GetCheckedSigninUrl(redirectUri string) (string, error) {
+	return c.GetCheckedSigninUrlWithContext(context.Background(), redirectUri)
+}
+
+func (c *Client) GetCheckedSigninUrlWithContext(ctx context.Context, redirectUri string) (string, error) {
+	err := c.CheckRedirectUriWithContext(ctx, redirectUri)
+	if err != nil {
+		return "", err
+	}
+	return c.GetSigninUrl(redirectUri), nil
+}
+
+// GetSilentSigninUrl returns the signin url with silent signin enabled: a user who still has a Casdoor session
+// is redirected back at once, without being shown the login page.
+func (c *Client) GetSilentSigninUrl(redirectUri string) string {
+	return c.GetSigninUrl(redirectUri) + "&silentSignin=1"
+}
+
+func (c *Client) GetUserProfileUrl(userName string, accessToken string) string {
+	authConfig := c.getAuthConfig()
+
 	param := ""
 	if accessToken != "" {
 		param = fmt.Sprintf("?access_token=%s", accessToken)
@@ -46,10 +93,86 @@ func GetUserProfileUrl(userName string, accessToken string) string {
 	return fmt.Sprintf("%s/users/%s/%s%s", authConfig.Endpoint, authConfig.OrganizationName, userName, param)
 }
 
-func GetMyProfileUrl(accessToken string) string {
+func (c *Client) GetMyProfileUrl(accessToken string) string {
+	authConfig := c.getAuthConfig()
+
 	param := ""
 	if accessToken != "" {
 		param = fmt.Sprintf("?access_token=%s", accessToken)
 	}
 	return fmt.Sprintf("%s/account%s", authConfig.Endpoint, param)
 }
+
+// GetPageUrl returns the url of a page of the Casdoor web UI, like "/account", with the non-empty params in its query.
+// Prefer the dedicated functions below, which keep the page paths consistent across server versions.
+func (c *Client) GetPageUrl(page string, params map[string]string) string {
+	authConfig := c.getAuthConfig()
+
+	query := url.Values{}
+	for k, v := range params {
+		if v != "" {
+			query.Set(k, v)
+		}
+	}
+
+	if len(query) == 0 {
+		return fmt.Sprintf("%s%s", authConfig.Endpoint, page)
+	}
+	return fmt.Sprintf("%s%s?%s", authConfig.Endpoint, page, query.Encode())
+}
+
+// GetAccountSettingsUrl returns the url of the account settings page of the signed-in user.
+// returnUrl is where the page sends the user back to, it can be empty.
+func (c *Client) GetAccountSettingsUrl(accessToken string, returnUrl string) string {
+	return c.GetPageUrl("/account", map[string]string{
+		"access_token": accessToken,
+		"returnUrl":    returnUrl,
+	})
+}
+
+// GetMfaSetupUrl returns the url of the page to set up a multi-factor authentication method of the signed-in user.
+// mfaType is the method to set up, like "app", "sms" or "email", or empty to let the user choose.
+func (c *Client) GetMfaSetupUrl(mfaType string, accessToken string, returnUrl string) string {
+	return c.GetPageUrl("/mfa/setup", map[string]string{
+		"mfaType":      mfaType,
+		"access_token": accessToken,
+		"returnUrl":    returnUrl,
+	})
+}
+
+// GetForgetPasswordUrl returns the url of the password reset page of the application.
+func (c *Client) GetForgetPasswordUrl(returnUrl string) string {
+	authConfig := c.getAuthConfig()
+
+	return c.GetPageUrl(fmt.Sprintf("/forget/%s", authConfig.ApplicationName), map[string]string{
+		"returnUrl": returnUrl,
+	})
+}
+
+// GetOrganizationSigninUrl returns the url of the sign-in page of an organization,
+// to send the user to after they selected the organization to sign in to.
+func (c *Client) GetOrganizationSigninUrl(organizationName string, returnUrl string) string {
+	return c.GetPageUrl(fmt.Sprintf("/login/%s", url.PathEscape(organizationName)), map[string]string{
+		"returnUrl": returnUrl,
+	})
+}
+
+// GetHandoffUrl adds the access token of an already authenticated user to pageUrl, a url of the Casdoor web UI
+// like the ones returned by GetAccountSettingsUrl, so that Casdoor signs the user in with it instead of asking
+// for a second interactive login. The token is checked first, and it should be short-lived since it ends up in the url.
+func (c *Client) GetHandoffUrl(pageUrl string, accessToken string) (string, error) {
+	_, err := c.ParseJwtToken(accessToken)
+	if err != nil {
+		return "", err
+	}
+
+	u, err := url.Parse(pageUrl)
+	if err != nil {
+		return "", err
+	}
+
+	query := u.Query()
+	query.Set("access_token", accessToken)
+	u.RawQuery = query.Encode()
+	return u.String(), nil
+}
@@ -0,0 +1,77 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import "context"
+
+func GetSignupUrl(enablePassword bool, redirectUri string) string {
+	return globalClient.GetSignupUrl(enablePassword, redirectUri)
+}
+
+func GetSigninUrl(redirectUri string) string {
+	return globalClient.GetSigninUrl(redirectUri)
+}
+
+func CheckRedirectUri(redirectUri string) error {
+	return globalClient.CheckRedirectUri(redirectUri)
+}
+
+func CheckRedirectUriWithContext(ctx context.Context, redirectUri string) error {
+	return globalClient.CheckRedirectUriWithContext(ctx, redirectUri)
+}
+
+func GetCheckedSigninUrl(redirectUri string) (string, error) {
+	return globalClient.GetCheckedSigninUrl(redirectUri)
+}
+
+func GetCheckedSigninUrlWithContext(ctx context.Context, redirectUri string) (string, error) {
+	return globalClient.GetCheckedSigninUrlWithContext(ctx, redirectUri)
+}
+
+func GetSilentSigninUrl(redirectUri string) string {
+	return globalClient.GetSilentSigninUrl(redirectUri)
+}
+
+func GetUserProfileUrl(userName string, accessToken string) string {
+	return globalClient.GetUserProfileUrl(userName, accessToken)
+}
+
+func GetMyProfileUrl(accessToken string) string {
+	return globalClient.GetMyProfileUrl(accessToken)
+}
+
+func GetPageUrl(page string, params map[string]string) string {
+	return globalClient.GetPageUrl(page, params)
+}
+
+func GetAccountSettingsUrl(accessToken string, returnUrl string) string {
+	return globalClient.GetAccountSettingsUrl(accessToken, returnUrl)
+}
+
+func GetMfaSetupUrl(mfaType string, accessToken string, returnUrl string) string {
+	return globalClient.GetMfaSetupUrl(mfaType, accessToken, returnUrl)
+}
+
+func GetForgetPasswordUrl(returnUrl string) string {
+	return globalClient.GetForgetPasswordUrl(returnUrl)
+}
+
+func GetOrganizationSigninUrl(organizationName string, returnUrl string) string {
+	return globalClient.GetOrganizationSigninUrl(organizationName, returnUrl)
+}
+
+func GetHandoffUrl(pageUrl string, accessToken string) (string, error) {
+	return globalClient.GetHandoffUrl(pageUrl, accessToken)
+}
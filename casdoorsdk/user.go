@@ -15,7 +15,9 @@
 package casdoorsdk
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strconv"
 )
@@ -27,14 +29,32 @@ type ManagedAccount struct {
 	SigninUrl   string `xorm:"varchar(200)" json:"signinUrl"`
 }
 
+type MfaProps struct {
+	Enabled       bool     `json:"enabled"`
+	IsPreferred   bool     `json:"isPreferred"`
+	MfaType       string   `json:"mfaType"`
+	Secret        string   `json:"secret,omitempty"`
+	CountryCode   string   `json:"countryCode,omitempty"`
+	URL           string   `json:"url,omitempty"`
+	RecoveryCodes []string `json:"recoveryCodes,omitempty"`
+}
+
+type MfaAccount struct {
+	AccountName string `json:"accountName"`
+	Issuer      string `json:"issuer"`
+	SecretKey   string `json:"secretKey"`
+}
+
 // User has the same definition as https://github.com/casdoor/casdoor/blob/master/object/user.go#L24
 type User struct {
 	Owner       string `xorm:"varchar(100) notnull pk" json:"owner"`
 	Name        string `xorm:"varchar(100) notnull pk" json:"name"`
 	CreatedTime string `xorm:"varchar(100) index" json:"createdTime"`
 	UpdatedTime string `xorm:"varchar(100)" json:"updatedTime"`
+	DeletedTime string `xorm:"varchar(100)" json:"deletedTime"`
 
 	Id                string   `xorm:"varchar(100) index" json:"id"`
+	ExternalId        string   `xorm:"varchar(100) index" json:"externalId"`
 	Type              string   `xorm:"varchar(100)" json:"type"`
 	Password          string   `xorm:"varchar(100)" json:"password"`
 	PasswordSalt      string   `xorm:"varchar(100)" json:"passwordSalt"`
@@ -66,6 +86,8 @@ type User struct {
 	Score             int      `json:"score"`
 	Karma             int      `json:"karma"`
 	Ranking           int      `json:"ranking"`
+	Balance           float64  `json:"balance"`
+	Currency          string   `xorm:"varchar(100)" json:"currency"`
 	IsDefaultAvatar   bool     `json:"isDefaultAvatar"`
 	IsOnline          bool     `json:"isOnline"`
 	IsAdmin           bool     `json:"isAdmin"`
@@ -78,6 +100,7 @@ type User struct {
 	Groups            []string `xorm:"varchar(1000)" json:"groups"`
 	AccessKey         string   `xorm:"varchar(100)" json:"accessKey"`
 	AccessSecret      string   `xorm:"varchar(100)" json:"accessSecret"`
+	AccessToken       string   `xorm:"mediumtext" json:"accessToken"`
 
 	CreatedIp      string `xorm:"varchar(100)" json:"createdIp"`
 	LastSigninTime string `xorm:"varchar(100)" json:"lastSigninTime"`
@@ -156,10 +179,20 @@ type User struct {
 	Yammer          string `xorm:"yammer varchar(100)" json:"yammer"`
 	Yandex          string `xorm:"yandex varchar(100)" json:"yandex"`
 	Zoom            string `xorm:"zoom varchar(100)" json:"zoom"`
+	Metamask        string `xorm:"metamask varchar(100)" json:"metamask"`
+	Web3Onboard     string `xorm:"web3onboard varchar(100)" json:"web3onboard"`
 	Custom          string `xorm:"custom varchar(100)" json:"custom"`
 
 	//WebauthnCredentials []webauthn.Credential `xorm:"webauthnCredentials blob" json:"webauthnCredentials"`
-	//MultiFactorAuths    []*MfaProps           `json:"multiFactorAuths"`
+	PreferredMfaType string       `xorm:"varchar(100)" json:"preferredMfaType"`
+	RecoveryCodes    []string     `xorm:"varchar(1000)" json:"recoveryCodes"`
+	TotpSecret       string       `xorm:"varchar(100)" json:"totpSecret"`
+	MfaPhoneEnabled  bool         `json:"mfaPhoneEnabled"`
+	MfaEmailEnabled  bool         `json:"mfaEmailEnabled"`
+	MultiFactorAuths []*MfaProps  `xorm:"-" json:"multiFactorAuths,omitempty"`
+	Invitation       string       `xorm:"varchar(100) index" json:"invitation"`
+	InvitationCode   string       `xorm:"varchar(100) index" json:"invitationCode"`
+	MfaAccounts      []MfaAccount `xorm:"mfaAccounts blob" json:"mfaAccounts"`
 
 	Ldap       string            `xorm:"ldap varchar(100)" json:"ldap"`
 	Properties map[string]string `json:"properties"`
@@ -167,189 +200,260 @@ type User struct {
 	Roles       []*Role       `json:"roles"`
 	Permissions []*Permission `json:"permissions"`
 
-	LastSigninWrongTime string `xorm:"varchar(100)" json:"lastSigninWrongTime"`
-	SigninWrongTimes    int    `json:"signinWrongTimes"`
+	LastChangePasswordTime string `xorm:"varchar(100)" json:"lastChangePasswordTime"`
+	LastSigninWrongTime    string `xorm:"varchar(100)" json:"lastSigninWrongTime"`
+	SigninWrongTimes       int    `json:"signinWrongTimes"`
+
+	ManagedAccounts    []ManagedAccount `xorm:"managedAccounts blob" json:"managedAccounts"`
+	NeedUpdatePassword bool             `json:"needUpdatePassword"`
+	IpWhitelist        string           `xorm:"varchar(200)" json:"ipWhitelist"`
+
+	// Extra holds the fields returned by the server that are not modeled above, e.g. fields added by newer Casdoor versions.
+	// They are sent back on update, so updating a previously fetched user doesn't wipe them on the server.
+	Extra map[string]json.RawMessage `xorm:"-" json:"-"`
+}
+
+// UserPatch describes a partial update of a user.
+// Only the fields that are set are written, so a field set to its zero value is cleared
+// while an unset field is left unchanged on the server.
+type UserPatch struct {
+	Type          Optional[string]
+	DisplayName   Optional[string]
+	FirstName     Optional[string]
+	LastName      Optional[string]
+	Avatar        Optional[string]
+	Email         Optional[string]
+	EmailVerified Optional[bool]
+	Phone         Optional[string]
+	CountryCode   Optional[string]
+	Region        Optional[string]
+	Location      Optional[string]
+	Address       Optional[[]string]
+	Affiliation   Optional[string]
+	Title         Optional[string]
+	IdCardType    Optional[string]
+	IdCard        Optional[string]
+	Homepage      Optional[string]
+	Bio           Optional[string]
+	Tag           Optional[string]
+	Language      Optional[string]
+	Gender        Optional[string]
+	Birthday      Optional[string]
+	Education     Optional[string]
+	Score         Optional[int]
+	Karma         Optional[int]
+	Ranking       Optional[int]
+	IsAdmin       Optional[bool]
+	IsForbidden   Optional[bool]
+	IsDeleted     Optional[bool]
+	Groups        Optional[[]string]
+	Properties    Optional[map[string]string]
+}
 
-	ManagedAccounts []ManagedAccount `xorm:"managedAccounts blob" json:"managedAccounts"`
+func (c *Client) GetUsers() ([]*User, error) {
+	return c.GetUsersWithContext(context.Background())
 }
 
-func GetUsers() ([]*User, error) {
+func (c *Client) GetUsersWithContext(ctx context.Context) ([]*User, error) {
+	authConfig := c.getAuthConfig()
+
 	queryMap := map[string]string{
 		"owner": authConfig.OrganizationName,
 	}
 
-	url := GetUrl("get-users", queryMap)
+	url := c.GetUrl("get-users", queryMap)
 
-	bytes, err := DoGetBytesRaw(url)
+	bytes, err := c.DoGetBytesRawWithContext(ctx, url)
 	if err != nil {
 		return nil, err
 	}
 
 	var users []*User
-	err = json.Unmarshal(bytes, &users)
+	err = c.unmarshal(bytes, &users)
 	if err != nil {
 		return nil, err
 	}
 	return users, nil
 }
 
-func GetSortedUsers(sorter string, limit int) ([]*User, error) {
+func (c *Client) GetSortedUsers(sorter string, limit int) ([]*User, error) {
+	return c.GetSortedUsersWithContext(context.Background(), sorter, limit)
+}
+
+func (c *Client) GetSortedUsersWithContext(ctx context.Context, sorter string, limit int) ([]*User, error) {
+	authConfig := c.getAuthConfig()
+
 	queryMap := map[string]string{
 		"owner":  authConfig.OrganizationName,
 		"sorter": sorter,
 		"limit":  strconv.Itoa(limit),
 	}
 
-	url := GetUrl("get-sorted-users", queryMap)
+	url := c.GetUrl("get-sorted-users", queryMap)
 
-	bytes, err := DoGetBytesRaw(url)
+	bytes, err := c.DoGetBytesRawWithContext(ctx, url)
 	if err != nil {
 		return nil, err
 	}
 
 	var users []*User
-	err = json.Unmarshal(bytes, &users)
+	err = c.unmarshal(bytes, &users)
 	if err != nil {
 		return nil, err
 	}
 	return users, nil
 }
 
-func GetPaginationUsers(p int, pageSize int, queryMap map[string]string) ([]*User, int, error) {
-	queryMap["owner"] = authConfig.OrganizationName
-	queryMap["p"] = strconv.Itoa(p)
-	queryMap["pageSize"] = strconv.Itoa(pageSize)
+// GetPaginationUsers returns the page p (counted from 1) of the users of the organization and the total count of users.
+// queryMap holds the other parameters of get-users, like field and value or sortField and sortOrder, and may be nil.
+func (c *Client) GetPaginationUsers(p int, pageSize int, queryMap map[string]string) ([]*User, int, error) {
+	return c.GetPaginationUsersWithContext(context.Background(), p, pageSize, queryMap)
+}
+
+func (c *Client) GetPaginationUsersWithContext(ctx context.Context, p int, pageSize int, queryMap map[string]string) ([]*User, int, error) {
+	authConfig := c.getAuthConfig()
+
+	queryMap = getPaginationQueryMap(authConfig.OrganizationName, p, pageSize, queryMap)
 
-	url := GetUrl("get-users", queryMap)
+	url := c.GetUrl("get-users", queryMap)
 
-	response, err := DoGetResponse(url)
+	response, err := c.DoGetResponseWithContext(ctx, url)
 	if err != nil {
 		return nil, 0, err
 	}
 
-	if response.Status != "ok" {
-		return nil, 0, fmt.Errorf(response.Msg)
-	}
-
 	bytes, err := json.Marshal(response.Data)
 	if err != nil {
 		return nil, 0, err
 	}
 
 	var users []*User
-	err = json.Unmarshal(bytes, &users)
+	err = c.unmarshal(bytes, &users)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	count, err := getCount(response)
 	if err != nil {
 		return nil, 0, err
 	}
-	return users, int(response.Data2.(float64)), nil
+	return users, count, nil
+}
+
+func (c *Client) GetUserCount(isOnline string) (int, error) {
+	return c.GetUserCountWithContext(context.Background(), isOnline)
 }
 
-func GetUserCount(isOnline string) (int, error) {
+func (c *Client) GetUserCountWithContext(ctx context.Context, isOnline string) (int, error) {
+	authConfig := c.getAuthConfig()
+
 	queryMap := map[string]string{
 		"owner":    authConfig.OrganizationName,
 		"isOnline": isOnline,
 	}
 
-	url := GetUrl("get-user-count", queryMap)
+	url := c.GetUrl("get-user-count", queryMap)
 
-	bytes, err := DoGetBytesRaw(url)
+	bytes, err := c.DoGetBytesRawWithContext(ctx, url)
 	if err != nil {
 		return -1, err
 	}
 
 	var count int
-	err = json.Unmarshal(bytes, &count)
+	err = c.unmarshal(bytes, &count)
 	if err != nil {
 		return -1, err
 	}
 	return count, nil
 }
 
-func GetUser(name string) (*User, error) {
+func (c *Client) GetUser(name string) (*User, error) {
+	return c.GetUserWithContext(context.Background(), name)
+}
+
+func (c *Client) GetUserWithContext(ctx context.Context, name string) (*User, error) {
+	authConfig := c.getAuthConfig()
+
 	queryMap := map[string]string{
 		"id": fmt.Sprintf("%s/%s", authConfig.OrganizationName, name),
 	}
 
-	url := GetUrl("get-user", queryMap)
+	url := c.GetUrl("get-user", queryMap)
 
-	bytes, err := DoGetBytesRaw(url)
+	bytes, err := c.DoGetBytesRawWithContext(ctx, url)
 	if err != nil {
 		return nil, err
 	}
 
 	var user *User
-	err = json.Unmarshal(bytes, &user)
+	err = c.unmarshal(bytes, &user)
 	if err != nil {
 		return nil, err
 	}
 	return user, nil
 }
 
-func GetUserByEmail(email string) (*User, error) {
-	queryMap := map[string]string{
-		"owner": authConfig.OrganizationName,
-		"email": email,
-	}
-
-	url := GetUrl("get-user", queryMap)
-
-	bytes, err := DoGetBytesRaw(url)
-	if err != nil {
-		return nil, err
-	}
+// GetUserByEmail returns the user of the organization whose email is email, or nil if there is none.
+func (c *Client) GetUserByEmail(email string) (*User, error) {
+	return c.GetUserByEmailWithContext(context.Background(), email)
+}
 
-	var user *User
-	err = json.Unmarshal(bytes, &user)
-	if err != nil {
-		return nil, err
-	}
-	return user, nil
+func (c *Client) GetUserByEmailWithContext(ctx context.Context, email string) (*User, error) {
+	return c.getUserByField(ctx, "email", email)
 }
 
-func GetUserByPhone(phone string) (*User, error) {
-	queryMap := map[string]string{
-		"owner": authConfig.OrganizationName,
-		"phone": phone,
-	}
+// GetUserByPhone returns the user of the organization whose phone is phone, or nil if there is none.
+func (c *Client) GetUserByPhone(phone string) (*User, error) {
+	return c.GetUserByPhoneWithContext(context.Background(), phone)
+}
 
-	url := GetUrl("get-user", queryMap)
+func (c *Client) GetUserByPhoneWithContext(ctx context.Context, phone string) (*User, error) {
+	return c.getUserByField(ctx, "phone", phone)
+}
 
-	bytes, err := DoGetBytesRaw(url)
-	if err != nil {
-		return nil, err
-	}
+// GetUserByUserId returns the user of the organization whose id (the Id field, not "owner/name") is userId,
+// or nil if there is none.
+func (c *Client) GetUserByUserId(userId string) (*User, error) {
+	return c.GetUserByUserIdWithContext(context.Background(), userId)
+}
 
-	var user *User
-	err = json.Unmarshal(bytes, &user)
-	if err != nil {
-		return nil, err
-	}
-	return user, nil
+func (c *Client) GetUserByUserIdWithContext(ctx context.Context, userId string) (*User, error) {
+	return c.getUserByField(ctx, "userId", userId)
 }
 
-func GetUserByUserId(userId string) (*User, error) {
+// getUserByField returns the user of the organization whose field, one of the fields get-user looks users up by,
+// is value, or nil if there is none.
+func (c *Client) getUserByField(ctx context.Context, field string, value string) (*User, error) {
+	authConfig := c.getAuthConfig()
+
 	queryMap := map[string]string{
-		"owner":  authConfig.OrganizationName,
-		"userId": userId,
+		"owner": authConfig.OrganizationName,
+		field:   value,
 	}
 
-	url := GetUrl("get-user", queryMap)
+	url := c.GetUrl("get-user", queryMap)
 
-	bytes, err := DoGetBytesRaw(url)
+	bytes, err := c.DoGetBytesRawWithContext(ctx, url)
 	if err != nil {
 		return nil, err
 	}
 
 	var user *User
-	err = json.Unmarshal(bytes, &user)
+	err = c.unmarshal(bytes, &user)
 	if err != nil {
 		return nil, err
 	}
 	return user, nil
 }
 
-// note: oldPassword is not required, if you don't need, just pass a empty string
-func SetPassword(owner, name, oldPassword, newPassword string) (bool, error) {
+// SetPassword changes the password of the user owner/name to newPassword. oldPassword is checked if it isn't empty,
+// which is required unless the client is an administrator. A wrong oldPassword is reported as an *APIError with
+// ErrorCodeWrongPassword, and a newPassword rejected by the password complexity options with ErrorCodeWeakPassword.
+func (c *Client) SetPassword(owner, name, oldPassword, newPassword string) (bool, error) {
+	return c.SetPasswordWithContext(context.Background(), owner, name, oldPassword, newPassword)
+}
+
+func (c *Client) SetPasswordWithContext(ctx context.Context, owner, name, oldPassword, newPassword string) (bool, error) {
 	param := map[string]string{
 		"userOwner":   owner,
 		"userName":    name,
@@ -362,7 +466,7 @@ func SetPassword(owner, name, oldPassword, newPassword string) (bool, error) {
 		return false, err
 	}
 
-	resp, err := DoPost("set-password", nil, bytes, true, false)
+	resp, err := c.DoPostWithContext(ctx, "set-password", nil, bytes, true, false)
 	if err != nil {
 		return false, err
 	}
@@ -370,34 +474,139 @@ func SetPassword(owner, name, oldPassword, newPassword string) (bool, error) {
 	return resp.Status == "ok", nil
 }
 
-func UpdateUserById(id string, user *User) (bool, error) {
-	_, affected, err := modifyUserById("update-user", id, user, nil)
+func (c *Client) UpdateUserById(id string, user *User) (bool, error) {
+	return c.UpdateUserByIdWithContext(context.Background(), id, user)
+}
+
+func (c *Client) UpdateUserByIdWithContext(ctx context.Context, id string, user *User) (bool, error) {
+	_, affected, err := c.modifyUserById(ctx, "update-user", id, user, nil)
 	return affected, err
 }
 
-func UpdateUser(user *User) (bool, error) {
-	_, affected, err := modifyUser("update-user", user, nil)
+func (c *Client) UpdateUser(user *User) (bool, error) {
+	return c.UpdateUserWithContext(context.Background(), user)
+}
+
+func (c *Client) UpdateUserWithContext(ctx context.Context, user *User) (bool, error) {
+	_, affected, err := c.modifyUser(ctx, "update-user", user, nil)
+	return affected, err
+}
+
+// PatchUser updates only the fields that are set in patch, see UserPatch.
+func (c *Client) PatchUser(name string, patch *UserPatch) (bool, error) {
+	return c.PatchUserWithContext(context.Background(), name, patch)
+}
+
+func (c *Client) PatchUserWithContext(ctx context.Context, name string, patch *UserPatch) (bool, error) {
+	authConfig := c.getAuthConfig()
+
+	user := &User{
+		Owner: authConfig.OrganizationName,
+		Name:  name,
+	}
+
+	columns := applyPatch(user, patch)
+	if len(columns) == 0 {
+		return false, nil
+	}
+
+	_, affected, err := c.modifyUser(ctx, "update-user", user, columns)
 	return affected, err
 }
 
-func UpdateUserForColumns(user *User, columns []string) (bool, error) {
-	_, affected, err := modifyUser("update-user", user, columns)
+func (c *Client) UpdateUserForColumns(user *User, columns []string) (bool, error) {
+	return c.UpdateUserForColumnsWithContext(context.Background(), user, columns)
+}
+
+func (c *Client) UpdateUserForColumnsWithContext(ctx context.Context, user *User, columns []string) (bool, error) {
+	_, affected, err := c.modifyUser(ctx, "update-user", user, columns)
 	return affected, err
 }
 
-func AddUser(user *User) (bool, error) {
-	_, affected, err := modifyUser("add-user", user, nil)
+func (c *Client) AddUser(user *User) (bool, error) {
+	return c.AddUserWithContext(context.Background(), user)
+}
+
+func (c *Client) AddUserWithContext(ctx context.Context, user *User) (bool, error) {
+	_, affected, err := c.modifyUser(ctx, "add-user", user, nil)
 	return affected, err
 }
 
-func DeleteUser(user *User) (bool, error) {
-	_, affected, err := modifyUser("delete-user", user, nil)
+// AddUsers adds users to the organization in a single request, see AddUsersInBatches for large imports.
+func (c *Client) AddUsers(users []*User) (bool, error) {
+	return c.AddUsersWithContext(context.Background(), users)
+}
+
+func (c *Client) AddUsersWithContext(ctx context.Context, users []*User) (bool, error) {
+	authConfig := c.getAuthConfig()
+
+	if len(users) == 0 {
+		return false, nil
+	}
+
+	for _, user := range users {
+		user.Owner = authConfig.OrganizationName
+	}
+	postBytes, err := json.Marshal(users)
+	if err != nil {
+		return false, err
+	}
+
+	resp, err := c.DoPostWithContext(ctx, "add-users", nil, postBytes, false, false)
+	if err != nil {
+		return false, err
+	}
+
+	return isAffected(resp), nil
+}
+
+func (c *Client) DeleteUser(user *User) (bool, error) {
+	return c.DeleteUserWithContext(context.Background(), user)
+}
+
+func (c *Client) DeleteUserWithContext(ctx context.Context, user *User) (bool, error) {
+	_, affected, err := c.modifyUser(ctx, "delete-user", user, nil)
 	return affected, err
 }
 
-func CheckUserPassword(user *User) (bool, error) {
-	response, _, err := modifyUser("check-user-password", user, nil)
-	return response.Status == "ok", err
+// CheckUserPassword reports whether user.Password is the password of the user named user.Name.
+// A wrong password returns false and a nil error, while the other rejections, like a locked account,
+// are returned as an *APIError.
+func (c *Client) CheckUserPassword(user *User) (bool, error) {
+	return c.CheckUserPasswordWithContext(context.Background(), user)
+}
+
+func (c *Client) CheckUserPasswordWithContext(ctx context.Context, user *User) (bool, error) {
+	_, _, err := c.modifyUser(ctx, "check-user-password", user, nil)
+	if err != nil {
+		var apiErr *APIError
+		if errors.As(err, &apiErr) && apiErr.Code == ErrorCodeWrongPassword {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (u *User) UnmarshalJSON(data []byte) error {
+	type user User
+	unknownFields, err := unmarshalWithUnknownFields(data, (*user)(u))
+	if err != nil {
+		return err
+	}
+
+	u.Extra = unknownFields
+	return nil
+}
+
+func (u User) getUnknownFields() map[string]json.RawMessage {
+	return u.Extra
+}
+
+func (u User) MarshalJSON() ([]byte, error) {
+	type user User
+	return marshalWithUnknownFields(u.Extra, user(u))
 }
 
 func (u User) GetId() string {
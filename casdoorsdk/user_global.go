@@ -0,0 +1,153 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import "context"
+
+func GetUsers() ([]*User, error) {
+	return globalClient.GetUsers()
+}
+
+func GetUsersWithContext(ctx context.Context) ([]*User, error) {
+	return globalClient.GetUsersWithContext(ctx)
+}
+
+func GetSortedUsers(sorter string, limit int) ([]*User, error) {
+	return globalClient.GetSortedUsers(sorter, limit)
+}
+
+func GetSortedUsersWithContext(ctx context.Context, sorter string, limit int) ([]*User, error) {
+	return globalClient.GetSortedUsersWithContext(ctx, sorter, limit)
+}
+
+func GetPaginationUsers(p int, pageSize int, queryMap map[string]string) ([]*User, int, error) {
+	return globalClient.GetPaginationUsers(p, pageSize, queryMap)
+}
+
+func GetPaginationUsersWithContext(ctx context.Context, p int, pageSize int, queryMap map[string]string) ([]*User, int, error) {
+	return globalClient.GetPaginationUsersWithContext(ctx, p, pageSize, queryMap)
+}
+
+func GetUserCount(isOnline string) (int, error) {
+	return globalClient.GetUserCount(isOnline)
+}
+
+func GetUserCountWithContext(ctx context.Context, isOnline string) (int, error) {
+	return globalClient.GetUserCountWithContext(ctx, isOnline)
+}
+
+func GetUser(name string) (*User, error) {
+	return globalClient.GetUser(name)
+}
+
+func GetUserWithContext(ctx context.Context, name string) (*User, error) {
+	return globalClient.GetUserWithContext(ctx, name)
+}
+
+func GetUserByEmail(email string) (*User, error) {
+	return globalClient.GetUserByEmail(email)
+}
+
+func GetUserByEmailWithContext(ctx context.Context, email string) (*User, error) {
+	return globalClient.GetUserByEmailWithContext(ctx, email)
+}
+
+func GetUserByPhone(phone string) (*User, error) {
+	return globalClient.GetUserByPhone(phone)
+}
+
+func GetUserByPhoneWithContext(ctx context.Context, phone string) (*User, error) {
+	return globalClient.GetUserByPhoneWithContext(ctx, phone)
+}
+
+func GetUserByUserId(userId string) (*User, error) {
+	return globalClient.GetUserByUserId(userId)
+}
+
+func GetUserByUserIdWithContext(ctx context.Context, userId string) (*User, error) {
+	return globalClient.GetUserByUserIdWithContext(ctx, userId)
+}
+
+func SetPassword(owner, name, oldPassword, newPassword string) (bool, error) {
+	return globalClient.SetPassword(owner, name, oldPassword, newPassword)
+}
+
+func SetPasswordWithContext(ctx context.Context, owner, name, oldPassword, newPassword string) (bool, error) {
+	return globalClient.SetPasswordWithContext(ctx, owner, name, oldPassword, newPassword)
+}
+
+func UpdateUserById(id string, user *User) (bool, error) {
+	return globalClient.UpdateUserById(id, user)
+}
+
+func UpdateUserByIdWithContext(ctx context.Context, id string, user *User) (bool, error) {
+	return globalClient.UpdateUserByIdWithContext(ctx, id, user)
+}
+
+func UpdateUser(user *User) (bool, error) {
+	return globalClient.UpdateUser(user)
+}
+
+func UpdateUserWithContext(ctx context.Context, user *User) (bool, error) {
+	return globalClient.UpdateUserWithContext(ctx, user)
+}
+
+func PatchUser(name string, patch *UserPatch) (bool, error) {
+	return globalClient.PatchUser(name, patch)
+}
+
+func PatchUserWithContext(ctx context.Context, name string, patch *UserPatch) (bool, error) {
+	return globalClient.PatchUserWithContext(ctx, name, patch)
+}
+
+func UpdateUserForColumns(user *User, columns []string) (bool, error) {
+	return globalClient.UpdateUserForColumns(user, columns)
+}
+
+func UpdateUserForColumnsWithContext(ctx context.Context, user *User, columns []string) (bool, error) {
+	return globalClient.UpdateUserForColumnsWithContext(ctx, user, columns)
+}
+
+func AddUser(user *User) (bool, error) {
+	return globalClient.AddUser(user)
+}
+
+func AddUserWithContext(ctx context.Context, user *User) (bool, error) {
+	return globalClient.AddUserWithContext(ctx, user)
+}
+
+func AddUsers(users []*User) (bool, error) {
+	return globalClient.AddUsers(users)
+}
+
+func AddUsersWithContext(ctx context.Context, users []*User) (bool, error) {
+	return globalClient.AddUsersWithContext(ctx, users)
+}
+
+func DeleteUser(user *User) (bool, error) {
+	return globalClient.DeleteUser(user)
+}
+
+func DeleteUserWithContext(ctx context.Context, user *User) (bool, error) {
+	return globalClient.DeleteUserWithContext(ctx, user)
+}
+
+func CheckUserPassword(user *User) (bool, error) {
+	return globalClient.CheckUserPassword(user)
+}
+
+func CheckUserPasswordWithContext(ctx context.Context, user *User) (bool, error) {
+	return globalClient.CheckUserPasswordWithContext(ctx, user)
+}
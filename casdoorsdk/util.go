@@ -16,24 +16,74 @@ package casdoorsdk
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"io"
 	"mime/multipart"
+	"net/url"
+	"reflect"
+	"sort"
+	"strconv"
 	"strings"
+	"sync"
 )
 
-func GetUrl(action string, queryMap map[string]string) string {
-	query := ""
+// GetUrl returns the url of an API action, with queryMap escaped into its query.
+func (c *Client) GetUrl(action string, queryMap map[string]string) string {
+	authConfig := c.getAuthConfig()
+
+	size := len(authConfig.Endpoint) + len("/api/") + len(action) + 1
+	for k, v := range queryMap {
+		size += len(k) + len(v) + 2
+	}
+
+	var sb strings.Builder
+	sb.Grow(size)
+	sb.WriteString(authConfig.Endpoint)
+	sb.WriteString("/api/")
+	sb.WriteString(action)
+	sb.WriteByte('?')
+
+	i := 0
+	for k, v := range queryMap {
+		if i != 0 {
+			sb.WriteByte('&')
+		}
+		sb.WriteString(url.QueryEscape(k))
+		sb.WriteByte('=')
+		sb.WriteString(url.QueryEscape(v))
+		i++
+	}
+
+	return sb.String()
+}
+
+// getPaginationQueryMap returns a copy of queryMap, which may be nil, with the owner and pagination parameters
+// of the actions listing objects, so that the caller can reuse its map for the next pages.
+func getPaginationQueryMap(owner string, p int, pageSize int, queryMap map[string]string) map[string]string {
+	res := make(map[string]string, len(queryMap)+3)
 	for k, v := range queryMap {
-		query += fmt.Sprintf("%s=%s&", k, v)
+		res[k] = v
 	}
-	query = strings.TrimRight(query, "&")
 
-	url := fmt.Sprintf("%s/api/%s?%s", authConfig.Endpoint, action, query)
-	return url
+	res["owner"] = owner
+	res["p"] = strconv.Itoa(p)
+	res["pageSize"] = strconv.Itoa(pageSize)
+	return res
 }
 
-func GetId(name string) string {
+// getCount returns the total count of objects sent in the Data2 of a paginated response.
+func getCount(response *Response) (int, error) {
+	count, ok := response.Data2.(float64)
+	if !ok {
+		return 0, fmt.Errorf("invalid count of objects: %v", response.Data2)
+	}
+	return int(count), nil
+}
+
+func (c *Client) GetId(name string) string {
+	authConfig := c.getAuthConfig()
+
 	return authConfig.OrganizationName + "/" + name
 }
 
@@ -69,3 +119,204 @@ func createForm(formData map[string]string) (string, io.Reader, error) {
 
 	return w.FormDataContentType(), body, nil
 }
+
+// unmarshal decodes the data of a response into v, honoring the strict decoding option of the client.
+func (c *Client) unmarshal(data []byte, v interface{}) error {
+	if !c.isStrictDecoding() {
+		return json.Unmarshal(data, v)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	err := decoder.Decode(v)
+	if err != nil {
+		return err
+	}
+	return checkUnknownFields(reflect.ValueOf(v))
+}
+
+// unknownFieldsKeeper is implemented by the objects keeping the fields of the server they don't model,
+// see unmarshalWithUnknownFields.
+type unknownFieldsKeeper interface {
+	getUnknownFields() map[string]json.RawMessage
+}
+
+// checkUnknownFields returns an error for the first unknown field kept by an object of v, like a User,
+// since these objects decode themselves and keep the fields they don't model regardless of DisallowUnknownFields.
+func checkUnknownFields(v reflect.Value) error {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		return checkUnknownFields(v.Elem())
+	case reflect.Slice, reflect.Array:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return nil
+		}
+		for i := 0; i < v.Len(); i++ {
+			err := checkUnknownFields(v.Index(i))
+			if err != nil {
+				return err
+			}
+		}
+	case reflect.Map:
+		iter := v.MapRange()
+		for iter.Next() {
+			err := checkUnknownFields(iter.Value())
+			if err != nil {
+				return err
+			}
+		}
+	case reflect.Struct:
+		if keeper, ok := v.Interface().(unknownFieldsKeeper); ok {
+			var names []string
+			for name := range keeper.getUnknownFields() {
+				names = append(names, name)
+			}
+			if len(names) != 0 {
+				sort.Strings(names)
+				return fmt.Errorf("json: unknown field \"%s\"", names[0])
+			}
+			return nil
+		}
+
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).PkgPath != "" {
+				continue
+			}
+
+			err := checkUnknownFields(v.Field(i))
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// unmarshalWithUnknownFields decodes data into each of values and returns the fields of data
+// that none of them models, so that they can be emitted again by marshalWithUnknownFields.
+func unmarshalWithUnknownFields(data []byte, values ...interface{}) (map[string]json.RawMessage, error) {
+	for _, v := range values {
+		err := json.Unmarshal(data, v)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var fields map[string]json.RawMessage
+	err := json.Unmarshal(data, &fields)
+	if err != nil {
+		return nil, err
+	}
+
+	knownNames := make([]map[string]bool, len(values))
+	for i, v := range values {
+		knownNames[i] = getJsonFieldNames(reflect.TypeOf(v).Elem())
+	}
+
+	for name := range fields {
+		// encoding/json matches field names case-insensitively
+		if isKnownName(knownNames, strings.ToLower(name)) {
+			delete(fields, name)
+		}
+	}
+
+	if len(fields) == 0 {
+		return nil, nil
+	}
+	return fields, nil
+}
+
+// marshalWithUnknownFields encodes values into a single JSON object and adds the unknown fields
+// that were captured by unmarshalWithUnknownFields, without overriding any modeled field.
+func marshalWithUnknownFields(unknownFields map[string]json.RawMessage, values ...interface{}) ([]byte, error) {
+	if len(values) == 1 && len(unknownFields) == 0 {
+		return json.Marshal(values[0])
+	}
+
+	fields := map[string]json.RawMessage{}
+	for _, v := range values {
+		data, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+
+		err = json.Unmarshal(data, &fields)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for name, value := range unknownFields {
+		if _, ok := fields[name]; !ok {
+			fields[name] = value
+		}
+	}
+
+	return json.Marshal(fields)
+}
+
+// jsonFieldNames caches the results of getJsonFieldNames by type.
+var jsonFieldNames sync.Map
+
+// getJsonFieldNames returns the lower-cased JSON names of the fields of struct type t.
+func getJsonFieldNames(t reflect.Type) map[string]bool {
+	if names, ok := jsonFieldNames.Load(t); ok {
+		return names.(map[string]bool)
+	}
+
+	names := map[string]bool{}
+	addJsonFieldNames(names, t)
+	jsonFieldNames.Store(t, names)
+	return names
+}
+
+func isKnownName(knownNames []map[string]bool, name string) bool {
+	for _, names := range knownNames {
+		if names[name] {
+			return true
+		}
+	}
+	return false
+}
+
+// addJsonFieldNames adds the lower-cased JSON names of the fields of struct type t to names.
+func addJsonFieldNames(names map[string]bool, t reflect.Type) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+
+		name := strings.Split(tag, ",")[0]
+		if name == "" && field.Anonymous && field.Type.Kind() == reflect.Struct {
+			addJsonFieldNames(names, field.Type)
+			continue
+		}
+		if field.PkgPath != "" {
+			continue
+		}
+
+		if name == "" {
+			name = field.Name
+		}
+		names[strings.ToLower(name)] = true
+	}
+}
+
+// getAction returns the API action of a url built by GetUrl, e.g. "get-user".
+func getAction(url string) string {
+	i := strings.Index(url, "/api/")
+	if i == -1 {
+		return ""
+	}
+
+	action := url[i+len("/api/"):]
+	if j := strings.IndexByte(action, '?'); j != -1 {
+		action = action[:j]
+	}
+	return action
+}
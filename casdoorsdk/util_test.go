@@ -0,0 +1,71 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestStrictDecodingIsPerClient(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[{"owner":"built-in","name":"alice","newField":1}]`))
+	}))
+	defer server.Close()
+
+	config := &AuthConfig{Endpoint: server.URL, ClientId: "client-id", ClientSecret: "client-secret", OrganizationName: "built-in"}
+	strictClient := NewClient(config)
+	strictClient.SetStrictDecoding(true)
+	client := NewClient(config)
+
+	_, err := strictClient.GetUsers()
+	if err == nil || !strings.Contains(err.Error(), `unknown field "newField"`) {
+		t.Errorf("err = %v, want the unknown field", err)
+	}
+
+	users, err := client.GetUsers()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(users) != 1 || string(users[0].Extra["newField"]) != "1" {
+		t.Errorf("the unknown field isn't kept by the client without strict decoding: %v", users)
+	}
+}
+
+func TestCheckUnknownFields(t *testing.T) {
+	client := NewClient(&AuthConfig{})
+	client.SetStrictDecoding(true)
+
+	var roles []*Role
+	err := client.unmarshal([]byte(`[{"owner":"built-in","name":"admin"}]`), &roles)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var permission *Permission
+	err = client.unmarshal([]byte(`{"owner":"built-in","name":"read","newField":true}`), &permission)
+	if err == nil {
+		t.Error("an unknown field of a permission is accepted")
+	}
+
+	var cert Cert
+	err = client.unmarshal([]byte(`{"owner":"admin","name":"cert-built-in","newField":true}`), &cert)
+	if err == nil {
+		t.Error("an unknown field of a cert is accepted")
+	}
+}
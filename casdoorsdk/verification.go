@@ -0,0 +1,89 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// SendVerificationCode sends a verification code to dest, an email address or a phone number.
+// method is what the code is for: "signup", "login", "forget" or "reset". countryCode is the
+// country of the phone number, like "US", and is ignored for email addresses.
+// captcha is the captcha solved by the user, if the application requires one; a rejected captcha
+// is reported as an *APIError with ErrorCodeCaptchaFailed.
+func (c *Client) SendVerificationCode(method string, dest string, countryCode string, captcha *Captcha) error {
+	return c.SendVerificationCodeWithContext(context.Background(), method, dest, countryCode, captcha)
+}
+
+func (c *Client) SendVerificationCodeWithContext(ctx context.Context, method string, dest string, countryCode string, captcha *Captcha) error {
+	authConfig := c.getAuthConfig()
+
+	destType := "phone"
+	if strings.Contains(dest, "@") {
+		destType = "email"
+	}
+
+	params := map[string]string{
+		"applicationId": fmt.Sprintf("admin/%s", authConfig.ApplicationName),
+		"method":        method,
+		"dest":          dest,
+		"type":          destType,
+		"countryCode":   countryCode,
+	}
+	if captcha != nil {
+		params["captchaType"] = captcha.Type
+		params["captchaToken"] = captcha.Token
+		params["clientSecret"] = captcha.ClientSecret
+	}
+
+	postBytes, err := json.Marshal(params)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.DoPostWithContext(ctx, "send-verification-code", nil, postBytes, true, false)
+	return err
+}
+
+// VerifyCode checks the verification code sent with SendVerificationCode to dest, the email address or
+// the phone number of a user of the organization, and disables it once it is verified. countryCode is as
+// for SendVerificationCode. A wrong or expired code is reported as an *APIError with ErrorCodeWrongCode
+// or ErrorCodeCodeExpired.
+func (c *Client) VerifyCode(dest string, code string, countryCode string) error {
+	return c.VerifyCodeWithContext(context.Background(), dest, code, countryCode)
+}
+
+func (c *Client) VerifyCodeWithContext(ctx context.Context, dest string, code string, countryCode string) error {
+	authConfig := c.getAuthConfig()
+
+	params := map[string]string{
+		"organization": authConfig.OrganizationName,
+		"application":  authConfig.ApplicationName,
+		"username":     dest,
+		"code":         code,
+		"countryCode":  countryCode,
+	}
+
+	postBytes, err := json.Marshal(params)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.DoPostWithContext(ctx, "verify-code", nil, postBytes, false, false)
+	return err
+}
@@ -0,0 +1,33 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import "context"
+
+func SendVerificationCode(method string, dest string, countryCode string, captcha *Captcha) error {
+	return globalClient.SendVerificationCode(method, dest, countryCode, captcha)
+}
+
+func SendVerificationCodeWithContext(ctx context.Context, method string, dest string, countryCode string, captcha *Captcha) error {
+	return globalClient.SendVerificationCodeWithContext(ctx, method, dest, countryCode, captcha)
+}
+
+func VerifyCode(dest string, code string, countryCode string) error {
+	return globalClient.VerifyCode(dest, code, countryCode)
+}
+
+func VerifyCodeWithContext(ctx context.Context, dest string, code string, countryCode string) error {
+	return globalClient.VerifyCodeWithContext(ctx, dest, code, countryCode)
+}
@@ -0,0 +1,159 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import (
+	"context"
+	"strconv"
+	"strings"
+)
+
+// VersionInfo has the same definition as https://github.com/casdoor/casdoor/blob/master/util/version.go
+type VersionInfo struct {
+	Version      string `json:"version"`
+	CommitId     string `json:"commitId"`
+	CommitOffset int    `json:"commitOffset"`
+}
+
+// Capabilities describes which features the Casdoor server supports, so that callers can
+// feature-detect instead of failing at runtime against older servers.
+// The SDK itself doesn't adapt its requests to the version of the server: the only difference it handles,
+// the result of the modify calls, is detected from the responses, see isAffected.
+type Capabilities struct {
+	Version VersionInfo
+
+	Groups      bool
+	Mfa         bool
+	Enforcers   bool
+	Invitations bool
+}
+
+// capabilityVersions are the first server versions that support each capability.
+var capabilityVersions = map[string][]int{
+	"groups":      {1, 344, 0},
+	"mfa":         {1, 305, 0},
+	"enforcers":   {1, 367, 0},
+	"invitations": {1, 475, 0},
+}
+
+func (c *Client) GetVersionInfo() (*VersionInfo, error) {
+	return c.GetVersionInfoWithContext(context.Background())
+}
+
+func (c *Client) GetVersionInfoWithContext(ctx context.Context) (*VersionInfo, error) {
+	url := c.GetUrl("get-version-info", nil)
+
+	bytes, err := c.DoGetBytesWithContext(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	var versionInfo VersionInfo
+	err = c.unmarshal(bytes, &versionInfo)
+	if err != nil {
+		return nil, err
+	}
+	return &versionInfo, nil
+}
+
+// GetCapabilities detects the version of the Casdoor server and returns the features it supports.
+// The detection is done by the first call and cached until the configuration of the client changes.
+// A server whose version can't be parsed, like one built from source, is assumed to support none of them.
+func (c *Client) GetCapabilities() (*Capabilities, error) {
+	return c.GetCapabilitiesWithContext(context.Background())
+}
+
+func (c *Client) GetCapabilitiesWithContext(ctx context.Context) (*Capabilities, error) {
+	endpoint := c.getAuthConfig().Endpoint
+
+	c.capabilitiesMutex.Lock()
+	if c.capabilities != nil && c.capabilitiesEndpoint == endpoint {
+		capabilities := c.capabilities
+		c.capabilitiesMutex.Unlock()
+		return capabilities, nil
+	}
+	c.capabilitiesMutex.Unlock()
+
+	// the version is fetched without holding the mutex, once for the concurrent callers
+	return c.capabilitiesFetches.do(ctx, endpoint, func() (*Capabilities, error) {
+		versionInfo, err := c.GetVersionInfoWithContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		capabilities := &Capabilities{
+			Version:     *versionInfo,
+			Groups:      isVersionAtLeast(versionInfo.Version, capabilityVersions["groups"]),
+			Mfa:         isVersionAtLeast(versionInfo.Version, capabilityVersions["mfa"]),
+			Enforcers:   isVersionAtLeast(versionInfo.Version, capabilityVersions["enforcers"]),
+			Invitations: isVersionAtLeast(versionInfo.Version, capabilityVersions["invitations"]),
+		}
+
+		c.capabilitiesMutex.Lock()
+		defer c.capabilitiesMutex.Unlock()
+
+		c.capabilities = capabilities
+		c.capabilitiesEndpoint = endpoint
+		return capabilities, nil
+	})
+}
+
+func (c *Client) resetCapabilities() {
+	c.capabilitiesMutex.Lock()
+	defer c.capabilitiesMutex.Unlock()
+
+	c.capabilities = nil
+	c.capabilitiesEndpoint = ""
+}
+
+// isVersionAtLeast compares a "v1.2.3" style version with minVersion, ignoring a "-beta" like suffix.
+// A version that can't be parsed, e.g. the empty version of the servers built from source, is never
+// at least minVersion, so that the features of an unknown server aren't assumed.
+func isVersionAtLeast(version string, minVersion []int) bool {
+	version = strings.TrimPrefix(version, "v")
+	if i := strings.IndexAny(version, "-+"); i >= 0 {
+		version = version[:i]
+	}
+
+	parts := strings.Split(version, ".")
+	for i, min := range minVersion {
+		if i >= len(parts) {
+			return false
+		}
+
+		n, err := strconv.Atoi(parts[i])
+		if err != nil {
+			return false
+		}
+
+		if n != min {
+			return n > min
+		}
+	}
+	return true
+}
+
+// isAffected reports whether a modify call changed anything on the server.
+// Current servers answer with the "Affected" string, while older ones answered with a plain boolean.
+func isAffected(resp *Response) bool {
+	switch data := resp.Data.(type) {
+	case string:
+		return data == "Affected"
+	case bool:
+		return data
+	default:
+		return false
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+)
+
+func TestIsVersionAtLeast(t *testing.T) {
+	tests := []struct {
+		version string
+		want    bool
+	}{
+		{"v1.344.0", true},
+		{"v1.400.2", true},
+		{"v2.0.0", true},
+		{"1.344.0", true},
+		{"v1.500.0-beta", true},
+		{"v1.343.9", false},
+		{"v0.999.0", false},
+		{"v1", false},
+		{"", false},
+		{"dev", false},
+		{"v1.x.0", false},
+	}
+
+	for _, test := range tests {
+		if got := isVersionAtLeast(test.version, []int{1, 344, 0}); got != test.want {
+			t.Errorf("isVersionAtLeast(%q) = %v, want %v", test.version, got, test.want)
+		}
+	}
+}
+
+func newVersionServer(t *testing.T, version string) (*httptest.Server, *int32) {
+	t.Helper()
+
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		_, _ = w.Write([]byte(`{"status":"ok","data":{"version":"` + version + `","commitId":"abc","commitOffset":0}}`))
+	}))
+	t.Cleanup(server.Close)
+	return server, &requests
+}
+
+func TestGetCapabilities(t *testing.T) {
+	server, requests := newVersionServer(t, "v1.350.0")
+	client := NewClient(&AuthConfig{Endpoint: server.URL})
+
+	runConcurrently(10, func(i int) {
+		capabilities, err := client.GetCapabilities()
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if !capabilities.Groups || !capabilities.Mfa || capabilities.Enforcers || capabilities.Invitations {
+			t.Errorf("got capabilities %+v of v1.350.0", capabilities)
+		}
+	}, func(i int) {
+		_, _ = client.GetCapabilities()
+	})
+	if n := atomic.LoadInt32(requests); n != 1 {
+		t.Errorf("the version was fetched %d times, want once", n)
+	}
+
+	devServer, _ := newVersionServer(t, "")
+	client.setConfig(&AuthConfig{Endpoint: devServer.URL})
+	capabilities, err := client.GetCapabilities()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if capabilities.Groups || capabilities.Mfa || capabilities.Enforcers || capabilities.Invitations {
+		t.Errorf("a server of unknown version claims capabilities %+v", capabilities)
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// ObjectChangeFunc is called by WatchObject with the JSON of the previous and the new version of the watched object.
+// new is "null" if the object has been deleted.
+type ObjectChangeFunc func(old json.RawMessage, new json.RawMessage)
+
+const watchJitter = 0.1
+
+// WatchObject polls the object of kind (like "user" or "application") with id ("owner/name") every interval,
+// and calls callback when the object changes, e.g. to reload the SAML metadata when the application changes.
+// Changes are detected with the updatedTime of the object when it has one, or else by comparing its content.
+//
+// WatchObject blocks until ctx is done and then returns ctx.Err(). It returns early if the object can't be fetched
+// the first time, while later failed polls are retried at the next interval.
+func (c *Client) WatchObject(ctx context.Context, kind string, id string, interval time.Duration, callback ObjectChangeFunc) error {
+	url := c.GetUrl(fmt.Sprintf("get-%s", kind), map[string]string{"id": id})
+
+	current, err := c.getWatchedObject(ctx, url)
+	if err != nil {
+		return err
+	}
+
+	for {
+		timer := time.NewTimer(addJitter(interval, watchJitter))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+
+		object, err := c.getWatchedObject(ctx, url)
+		if err != nil {
+			continue
+		}
+
+		if isObjectChanged(current, object) {
+			callback(current, object)
+			current = object
+		}
+	}
+}
+
+// getWatchedObject returns the JSON of an object, whether or not the server wraps it into a Response.
+func (c *Client) getWatchedObject(ctx context.Context, url string) (json.RawMessage, error) {
+	bytes, statusCode, err := c.doGet(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		Status *string         `json:"status"`
+		Msg    string          `json:"msg"`
+		Data   json.RawMessage `json:"data"`
+	}
+	err = json.Unmarshal(bytes, &response)
+	if err != nil || response.Status == nil {
+		return bytes, nil
+	}
+
+	if *response.Status != "ok" {
+		return nil, c.newResponseError("GET", url, statusCode, bytes, response.Msg)
+	}
+	if response.Data == nil {
+		return json.RawMessage("null"), nil
+	}
+	return response.Data, nil
+}
+
+func isObjectChanged(old json.RawMessage, new json.RawMessage) bool {
+	var oldObject, newObject struct {
+		UpdatedTime string `json:"updatedTime"`
+	}
+
+	if json.Unmarshal(old, &oldObject) == nil && json.Unmarshal(new, &newObject) == nil &&
+		oldObject.UpdatedTime != "" && newObject.UpdatedTime != "" {
+		return oldObject.UpdatedTime != newObject.UpdatedTime
+	}
+
+	return !bytes.Equal(old, new)
+}
@@ -0,0 +1,163 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+type Header struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// Webhook has the same definition as https://github.com/casdoor/casdoor/blob/master/object/webhook.go#L29
+type Webhook struct {
+	Owner       string `xorm:"varchar(100) notnull pk" json:"owner"`
+	Name        string `xorm:"varchar(100) notnull pk" json:"name"`
+	CreatedTime string `xorm:"varchar(100)" json:"createdTime"`
+
+	Organization string `xorm:"varchar(100) index" json:"organization"`
+
+	Url            string    `xorm:"varchar(100)" json:"url"`
+	Method         string    `xorm:"varchar(100)" json:"method"`
+	ContentType    string    `xorm:"varchar(100)" json:"contentType"`
+	Headers        []*Header `xorm:"mediumtext" json:"headers"`
+	Events         []string  `xorm:"varchar(1000)" json:"events"`
+	IsUserExtended bool      `json:"isUserExtended"`
+	IsEnabled      bool      `json:"isEnabled"`
+}
+
+// GetWebhooks returns the webhooks of the configured organization.
+func (c *Client) GetWebhooks() ([]*Webhook, error) {
+	return c.GetWebhooksWithContext(context.Background())
+}
+
+func (c *Client) GetWebhooksWithContext(ctx context.Context) ([]*Webhook, error) {
+	authConfig := c.getAuthConfig()
+
+	queryMap := map[string]string{
+		"owner":        "admin",
+		"organization": authConfig.OrganizationName,
+	}
+
+	url := c.GetUrl("get-webhooks", queryMap)
+
+	bytes, err := c.DoGetBytesWithContext(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	var webhooks []*Webhook
+	err = c.unmarshal(bytes, &webhooks)
+	if err != nil {
+		return nil, err
+	}
+	return webhooks, nil
+}
+
+func (c *Client) GetPaginationWebhooks(p int, pageSize int, queryMap map[string]string) ([]*Webhook, int, error) {
+	return c.GetPaginationWebhooksWithContext(context.Background(), p, pageSize, queryMap)
+}
+
+func (c *Client) GetPaginationWebhooksWithContext(ctx context.Context, p int, pageSize int, queryMap map[string]string) ([]*Webhook, int, error) {
+	authConfig := c.getAuthConfig()
+
+	queryMap = getPaginationQueryMap("admin", p, pageSize, queryMap)
+	if _, ok := queryMap["organization"]; !ok {
+		queryMap["organization"] = authConfig.OrganizationName
+	}
+
+	url := c.GetUrl("get-webhooks", queryMap)
+
+	response, err := c.DoGetResponseWithContext(ctx, url)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	bytes, err := json.Marshal(response.Data)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var webhooks []*Webhook
+	err = c.unmarshal(bytes, &webhooks)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	count, err := getCount(response)
+	if err != nil {
+		return nil, 0, err
+	}
+	return webhooks, count, nil
+}
+
+func (c *Client) GetWebhook(name string) (*Webhook, error) {
+	return c.GetWebhookWithContext(context.Background(), name)
+}
+
+func (c *Client) GetWebhookWithContext(ctx context.Context, name string) (*Webhook, error) {
+	queryMap := map[string]string{
+		"id": fmt.Sprintf("admin/%s", name),
+	}
+
+	url := c.GetUrl("get-webhook", queryMap)
+
+	bytes, err := c.DoGetBytesWithContext(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	var webhook *Webhook
+	err = c.unmarshal(bytes, &webhook)
+	if err != nil {
+		return nil, err
+	}
+	return webhook, nil
+}
+
+func (c *Client) UpdateWebhook(webhook *Webhook) (bool, error) {
+	return c.UpdateWebhookWithContext(context.Background(), webhook)
+}
+
+func (c *Client) UpdateWebhookWithContext(ctx context.Context, webhook *Webhook) (bool, error) {
+	_, affected, err := c.modifyWebhook(ctx, "update-webhook", webhook)
+	return affected, err
+}
+
+func (c *Client) AddWebhook(webhook *Webhook) (bool, error) {
+	return c.AddWebhookWithContext(context.Background(), webhook)
+}
+
+func (c *Client) AddWebhookWithContext(ctx context.Context, webhook *Webhook) (bool, error) {
+	_, affected, err := c.modifyWebhook(ctx, "add-webhook", webhook)
+	return affected, err
+}
+
+func (c *Client) DeleteWebhook(name string) (bool, error) {
+	return c.DeleteWebhookWithContext(context.Background(), name)
+}
+
+func (c *Client) DeleteWebhookWithContext(ctx context.Context, name string) (bool, error) {
+	webhook := Webhook{
+		Owner: "admin",
+		Name:  name,
+	}
+	_, affected, err := c.modifyWebhook(ctx, "delete-webhook", &webhook)
+	return affected, err
+}
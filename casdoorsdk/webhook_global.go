@@ -0,0 +1,65 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import "context"
+
+func GetWebhooks() ([]*Webhook, error) {
+	return globalClient.GetWebhooks()
+}
+
+func GetWebhooksWithContext(ctx context.Context) ([]*Webhook, error) {
+	return globalClient.GetWebhooksWithContext(ctx)
+}
+
+func GetPaginationWebhooks(p int, pageSize int, queryMap map[string]string) ([]*Webhook, int, error) {
+	return globalClient.GetPaginationWebhooks(p, pageSize, queryMap)
+}
+
+func GetPaginationWebhooksWithContext(ctx context.Context, p int, pageSize int, queryMap map[string]string) ([]*Webhook, int, error) {
+	return globalClient.GetPaginationWebhooksWithContext(ctx, p, pageSize, queryMap)
+}
+
+func GetWebhook(name string) (*Webhook, error) {
+	return globalClient.GetWebhook(name)
+}
+
+func GetWebhookWithContext(ctx context.Context, name string) (*Webhook, error) {
+	return globalClient.GetWebhookWithContext(ctx, name)
+}
+
+func UpdateWebhook(webhook *Webhook) (bool, error) {
+	return globalClient.UpdateWebhook(webhook)
+}
+
+func UpdateWebhookWithContext(ctx context.Context, webhook *Webhook) (bool, error) {
+	return globalClient.UpdateWebhookWithContext(ctx, webhook)
+}
+
+func AddWebhook(webhook *Webhook) (bool, error) {
+	return globalClient.AddWebhook(webhook)
+}
+
+func AddWebhookWithContext(ctx context.Context, webhook *Webhook) (bool, error) {
+	return globalClient.AddWebhookWithContext(ctx, webhook)
+}
+
+func DeleteWebhook(name string) (bool, error) {
+	return globalClient.DeleteWebhook(name)
+}
+
+func DeleteWebhookWithContext(ctx context.Context, name string) (bool, error) {
+	return globalClient.DeleteWebhookWithContext(ctx, name)
+}
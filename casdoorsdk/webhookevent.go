@@ -0,0 +1,223 @@
+// Copyright 2023 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// DefaultWebhookSecretHeader is the header WebhookHandler reads the secret from by default.
+// Casdoor sends the headers set in the Headers of the webhook, so add it there with the secret as value.
+const DefaultWebhookSecretHeader = "X-Webhook-Secret"
+
+// maxWebhookBodySize is the maximum size of the body of a webhook request.
+const maxWebhookBodySize = 1 << 20
+
+// WebhookEvent is an event sent by a Casdoor webhook. Its concrete type is one of the *Event types below,
+// or *UnknownEvent for the actions without a type of their own.
+type WebhookEvent interface {
+	// GetRecord returns the record of the action that triggered the webhook.
+	GetRecord() *Record
+}
+
+// UserCreatedEvent is sent when a user signs up or is added by an administrator.
+type UserCreatedEvent struct {
+	Record *Record
+	// User is the created user, if the server sent it.
+	User *User
+}
+
+// UserUpdatedEvent is sent when a user is updated.
+type UserUpdatedEvent struct {
+	Record *Record
+	// User is the updated user, if the server sent it.
+	User *User
+}
+
+// UserDeletedEvent is sent when a user is deleted.
+type UserDeletedEvent struct {
+	Record *Record
+	// User is the deleted user, if the server sent it.
+	User *User
+}
+
+// LoginSucceededEvent is sent when a user logs in.
+type LoginSucceededEvent struct {
+	Record *Record
+	// User is the user who logged in, if the webhook is user extended.
+	User *User
+}
+
+// LogoutEvent is sent when a user logs out.
+type LogoutEvent struct {
+	Record *Record
+}
+
+// UnknownEvent is sent for the other actions.
+type UnknownEvent struct {
+	Record *Record
+}
+
+func (e *UserCreatedEvent) GetRecord() *Record    { return e.Record }
+func (e *UserUpdatedEvent) GetRecord() *Record    { return e.Record }
+func (e *UserDeletedEvent) GetRecord() *Record    { return e.Record }
+func (e *LoginSucceededEvent) GetRecord() *Record { return e.Record }
+func (e *LogoutEvent) GetRecord() *Record         { return e.Record }
+func (e *UnknownEvent) GetRecord() *Record        { return e.Record }
+
+// ParseWebhookEvent decodes the body of a webhook request, which is the record of the action, into a typed event.
+func ParseWebhookEvent(data []byte) (WebhookEvent, error) {
+	var record Record
+	err := json.Unmarshal(data, &record)
+	if err != nil {
+		return nil, err
+	}
+	if record.Action == "" {
+		return nil, fmt.Errorf("invalid webhook event: no action")
+	}
+
+	switch record.Action {
+	case "signup", "add-user":
+		return &UserCreatedEvent{Record: &record, User: getEventUser(&record)}, nil
+	case "update-user":
+		return &UserUpdatedEvent{Record: &record, User: getEventUser(&record)}, nil
+	case "delete-user":
+		return &UserDeletedEvent{Record: &record, User: getEventUser(&record)}, nil
+	case "login":
+		return &LoginSucceededEvent{Record: &record, User: record.ExtendedUser}, nil
+	case "logout":
+		return &LogoutEvent{Record: &record}, nil
+	default:
+		return &UnknownEvent{Record: &record}, nil
+	}
+}
+
+// getEventUser returns the user a user action was about: the object of the request, or else the extended user.
+func getEventUser(record *Record) *User {
+	if record.Object != "" {
+		var user User
+		if json.Unmarshal([]byte(record.Object), &user) == nil && user.Name != "" {
+			return &user
+		}
+	}
+	return record.ExtendedUser
+}
+
+// WebhookHandler is an http.Handler receiving the requests of a Casdoor webhook and dispatching their events
+// to the callbacks. A callback returning an error makes the request fail with a 500 status.
+type WebhookHandler struct {
+	// Secret, if not empty, must be the value of the SecretHeader of the requests,
+	// or the hex-encoded HMAC-SHA256 of their body keyed by Secret, optionally prefixed by "sha256=".
+	Secret string
+	// SecretHeader is DefaultWebhookSecretHeader if empty.
+	SecretHeader string
+
+	OnUserCreated    func(ctx context.Context, event *UserCreatedEvent) error
+	OnUserUpdated    func(ctx context.Context, event *UserUpdatedEvent) error
+	OnUserDeleted    func(ctx context.Context, event *UserDeletedEvent) error
+	OnLoginSucceeded func(ctx context.Context, event *LoginSucceededEvent) error
+	OnLogout         func(ctx context.Context, event *LogoutEvent) error
+	// OnEvent is called for every event, after its typed callback if any.
+	OnEvent func(ctx context.Context, event WebhookEvent) error
+}
+
+func (h *WebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxWebhookBodySize))
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	if !h.isAuthorized(r, body) {
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return
+	}
+
+	event, err := ParseWebhookEvent(body)
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	err = h.dispatch(r.Context(), event)
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (h *WebhookHandler) isAuthorized(r *http.Request, body []byte) bool {
+	if h.Secret == "" {
+		return true
+	}
+
+	header := h.SecretHeader
+	if header == "" {
+		header = DefaultWebhookSecretHeader
+	}
+
+	value := r.Header.Get(header)
+	if value == "" {
+		return false
+	}
+	return secureCompare(value, h.Secret) || verifyHmacSha256([]byte(h.Secret), body, strings.TrimPrefix(value, "sha256="))
+}
+
+func (h *WebhookHandler) dispatch(ctx context.Context, event WebhookEvent) error {
+	var err error
+	switch e := event.(type) {
+	case *UserCreatedEvent:
+		if h.OnUserCreated != nil {
+			err = h.OnUserCreated(ctx, e)
+		}
+	case *UserUpdatedEvent:
+		if h.OnUserUpdated != nil {
+			err = h.OnUserUpdated(ctx, e)
+		}
+	case *UserDeletedEvent:
+		if h.OnUserDeleted != nil {
+			err = h.OnUserDeleted(ctx, e)
+		}
+	case *LoginSucceededEvent:
+		if h.OnLoginSucceeded != nil {
+			err = h.OnLoginSucceeded(ctx, e)
+		}
+	case *LogoutEvent:
+		if h.OnLogout != nil {
+			err = h.OnLogout(ctx, e)
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	if h.OnEvent != nil {
+		return h.OnEvent(ctx, event)
+	}
+	return nil
+}